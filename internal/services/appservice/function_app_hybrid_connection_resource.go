@@ -8,7 +8,6 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2017-04-01/hybridconnections"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2017-04-01/namespaces"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -160,13 +159,19 @@ func (r FunctionAppHybridConnectionResource) Create() sdk.ResourceFunc {
 				return tf.ImportAsExistsError(r.ResourceType(), *existing.ID)
 			}
 
+			// Linux Apps don't retrieve the Send Key Value from the Relay themselves, so it must be supplied on creation
+			sendKeyValue, err := helpers.GetSendKeyValue(ctx, metadata, *relayId, appHybridConn.SendKeyName)
+			if err != nil {
+				return err
+			}
+
 			envelope := web.HybridConnection{
 				HybridConnectionProperties: &web.HybridConnectionProperties{
 					RelayArmURI:  utils.String(relayId.ID()),
 					Hostname:     utils.String(appHybridConn.HostName),
 					Port:         utils.Int32(int32(appHybridConn.HostPort)),
 					SendKeyName:  utils.String(appHybridConn.SendKeyName),
-					SendKeyValue: utils.String(""),
+					SendKeyValue: sendKeyValue,
 				},
 			}
 
@@ -218,28 +223,15 @@ func (r FunctionAppHybridConnectionResource) Read() sdk.ResourceFunc {
 				appHybridConn.SendKeyValue = utils.NormalizeNilableString(props.SendKeyValue)
 			}
 
-			if appHybridConn.ServiceBusNamespace != "" && appHybridConn.SendKeyName != "" {
-				relayNamespaceClient := metadata.Client.Relay.NamespacesClient
+			if appHybridConn.RelayId != "" && appHybridConn.SendKeyName != "" {
 				relayId, err := hybridconnections.ParseHybridConnectionID(appHybridConn.RelayId)
 				if err != nil {
 					return err
 				}
 
-				if keys, err := relayNamespaceClient.ListKeys(ctx, namespaces.NewAuthorizationRuleID(id.SubscriptionId, relayId.ResourceGroupName, appHybridConn.ServiceBusNamespace, appHybridConn.SendKeyName)); err != nil && keys.Model != nil {
-					appHybridConn.SendKeyValue = utils.NormalizeNilableString(keys.Model.PrimaryKey)
-					return metadata.Encode(&appHybridConn)
-				}
-
-				hybridConnectionsClient := metadata.Client.Relay.HybridConnectionsClient
-				hybridConnectionID, err := hybridconnections.ParseHybridConnectionID(appHybridConn.RelayId)
-				if err != nil {
-					return err
-				}
-
-				ruleID := hybridconnections.NewHybridConnectionAuthorizationRuleID(id.SubscriptionId, hybridConnectionID.ResourceGroupName, appHybridConn.ServiceBusNamespace, *existing.Name, appHybridConn.SendKeyName)
-				keys, err := hybridConnectionsClient.ListKeys(ctx, ruleID)
-				if err != nil && keys.Model != nil {
-					appHybridConn.SendKeyValue = utils.NormalizeNilableString(keys.Model.PrimaryKey)
+				// the key may not be retrievable if the caller lacks `listKeys` permissions on the Relay, which shouldn't fail the read
+				if key, err := helpers.GetSendKeyValue(ctx, metadata, *relayId, appHybridConn.SendKeyName); err == nil {
+					appHybridConn.SendKeyValue = utils.NormalizeNilableString(key)
 				}
 			}
 
@@ -305,7 +297,11 @@ func (r FunctionAppHybridConnectionResource) Update() sdk.ResourceFunc {
 			}
 
 			if metadata.ResourceData.HasChange("send_key_name") {
-				key, err := helpers.GetSendKeyValue(ctx, metadata, *id, appHybridConn.SendKeyName)
+				relayId, err := hybridconnections.ParseHybridConnectionID(appHybridConn.RelayId)
+				if err != nil {
+					return err
+				}
+				key, err := helpers.GetSendKeyValue(ctx, metadata, *relayId, appHybridConn.SendKeyName)
 				if err != nil {
 					return err
 				}
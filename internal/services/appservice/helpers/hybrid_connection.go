@@ -4,20 +4,27 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2017-04-01/hybridconnections"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2017-04-01/namespaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
 )
 
-func GetSendKeyValue(ctx context.Context, metadata sdk.ResourceMetaData, id parse.AppHybridConnectionId, sendKeyName string) (*string, error) {
-	relayClient := metadata.Client.Relay.NamespacesClient
-	connectionId := namespaces.NewAuthorizationRuleID(id.SubscriptionId, id.ResourceGroup, id.HybridConnectionNamespaceName, sendKeyName)
-	keys, err := relayClient.ListKeys(ctx, connectionId)
+// GetSendKeyValue returns the Primary Key of the named Send rule, which can either be defined on the Relay Namespace
+// or on the Hybrid Connection itself.
+func GetSendKeyValue(ctx context.Context, metadata sdk.ResourceMetaData, relayId hybridconnections.HybridConnectionId, sendKeyName string) (*string, error) {
+	namespaceRuleId := namespaces.NewAuthorizationRuleID(relayId.SubscriptionId, relayId.ResourceGroupName, relayId.NamespaceName, sendKeyName)
+	if keys, err := metadata.Client.Relay.NamespacesClient.ListKeys(ctx, namespaceRuleId); err == nil && keys.Model != nil && keys.Model.PrimaryKey != nil {
+		return keys.Model.PrimaryKey, nil
+	}
+
+	hybridConnectionRuleId := hybridconnections.NewHybridConnectionAuthorizationRuleID(relayId.SubscriptionId, relayId.ResourceGroupName, relayId.NamespaceName, relayId.HybridConnectionName, sendKeyName)
+	keys, err := metadata.Client.Relay.HybridConnectionsClient.ListKeys(ctx, hybridConnectionRuleId)
 	if err != nil {
-		return nil, fmt.Errorf("listing Send Keys for %s in %s: %+v", connectionId, id, err)
+		return nil, fmt.Errorf("listing Send Keys for %s: %+v", hybridConnectionRuleId, err)
 	}
-	if err != nil || keys.Model == nil || keys.Model.PrimaryKey == nil {
-		return nil, fmt.Errorf("reading Send Key Value for %s in %s", connectionId.AuthorizationRuleName, id)
+	if keys.Model == nil || keys.Model.PrimaryKey == nil {
+		return nil, fmt.Errorf("reading Send Key Value for %s", hybridConnectionRuleId)
 	}
+
 	return keys.Model.PrimaryKey, nil
 }
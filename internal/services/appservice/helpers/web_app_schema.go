@@ -3803,7 +3803,9 @@ func ExpandAppSettingsForCreate(settings map[string]string) *[]web.NameValuePair
 }
 
 func FlattenAppSettings(input web.StringDictionary) (map[string]string, *int) {
-	maxPingFailures := "WEBSITE_HEALTHCHECK_MAXPINGFAILURE"
+	maxPingFailures := "WEBSITE_HEALTHCHECK_MAXPINGFAILURES"
+	// previous versions of the provider set the value under this (incorrect) name, so it is still read and removed here
+	legacyMaxPingFailures := "WEBSITE_HEALTHCHECK_MAXPINGFAILURE"
 	unmanagedSettings := []string{
 		"DIAGNOSTICS_AZUREBLOBCONTAINERSASURL",
 		"DIAGNOSTICS_AZUREBLOBRETENTIONINDAYS",
@@ -3811,13 +3813,16 @@ func FlattenAppSettings(input web.StringDictionary) (map[string]string, *int) {
 		"WEBSITE_HTTPLOGGING_RETENTION_DAYS",
 		"WEBSITE_VNET_ROUTE_ALL",
 		maxPingFailures,
+		legacyMaxPingFailures,
 	}
 
 	var healthCheckCount *int
 	appSettings := FlattenWebStringDictionary(input)
-	if v, ok := appSettings[maxPingFailures]; ok {
-		h, _ := strconv.Atoi(v)
-		healthCheckCount = &h
+	for _, k := range []string{legacyMaxPingFailures, maxPingFailures} {
+		if v, ok := appSettings[k]; ok {
+			h, _ := strconv.Atoi(v)
+			healthCheckCount = &h
+		}
 	}
 
 	// Remove the settings the service adds for legacy reasons.
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type WebAppStickySettingsId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	SiteName       string
+	ConfigName     string
+}
+
+func NewWebAppStickySettingsID(subscriptionId, resourceGroup, siteName, configName string) WebAppStickySettingsId {
+	return WebAppStickySettingsId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		SiteName:       siteName,
+		ConfigName:     configName,
+	}
+}
+
+func (id WebAppStickySettingsId) String() string {
+	segments := []string{
+		fmt.Sprintf("Config Name %q", id.ConfigName),
+		fmt.Sprintf("Site Name %q", id.SiteName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Web App Sticky Settings", segmentsStr)
+}
+
+func (id WebAppStickySettingsId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s/config/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SiteName, id.ConfigName)
+}
+
+// WebAppStickySettingsID parses a WebAppStickySettings ID into an WebAppStickySettingsId struct
+func WebAppStickySettingsID(input string) (*WebAppStickySettingsId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := WebAppStickySettingsId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.SiteName, err = id.PopSegment("sites"); err != nil {
+		return nil, err
+	}
+	if resourceId.ConfigName, err = id.PopSegment("config"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -97,6 +97,22 @@ func TestAccWebAppHybridConnection_complete(t *testing.T) {
 	})
 }
 
+func TestAccWebAppHybridConnection_linux(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_hybrid_connection", "test")
+	r := WebAppHybridConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.linux(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("send_key_value").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r WebAppHybridConnectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.AppHybridConnectionID(state.ID)
 	if err != nil {
@@ -199,6 +215,23 @@ resource "azurerm_web_app_hybrid_connection" "test" {
 `, r.authRuleInRemoteResourceGroupTemplate(data), data.RandomStringOfLength(8))
 }
 
+func (r WebAppHybridConnectionResource) linux(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_web_app_hybrid_connection" "test" {
+  web_app_id = azurerm_linux_web_app.test.id
+  relay_id   = azurerm_relay_hybrid_connection.test.id
+  hostname   = "acctest%[2]s.hostname"
+  port       = 8081
+}
+`, r.templateLinux(data), data.RandomStringOfLength(8))
+}
+
 func (r WebAppHybridConnectionResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -280,3 +313,44 @@ resource "azurerm_relay_hybrid_connection_authorization_rule" "test" {
 
 `, r.template(data), data.RandomInteger, data.Locations.Primary)
 }
+
+func (r WebAppHybridConnectionResource) templateLinux(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_service_plan" "test" {
+  name                = "acctestASP-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  os_type             = "Linux"
+  sku_name            = "%[3]s"
+}
+
+resource "azurerm_relay_namespace" "test" {
+  name                = "acctest-RN-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku_name = "Standard"
+}
+
+resource "azurerm_relay_hybrid_connection" "test" {
+  name                 = "acctest-RHC-%[1]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  relay_namespace_name = azurerm_relay_namespace.test.name
+  user_metadata        = "metadatatest"
+}
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  site_config {}
+}
+`, data.RandomInteger, data.Locations.Primary, SkuBasicPlan)
+}
@@ -0,0 +1,252 @@
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type WebAppStickySettingsResource struct{}
+
+type WebAppStickySettingsModel struct {
+	WebAppId              string   `tfschema:"web_app_id"`
+	AppSettingNames       []string `tfschema:"app_setting_names"`
+	ConnectionStringNames []string `tfschema:"connection_string_names"`
+}
+
+var _ sdk.ResourceWithUpdate = WebAppStickySettingsResource{}
+
+func (r WebAppStickySettingsResource) ModelObject() interface{} {
+	return &WebAppStickySettingsModel{}
+}
+
+func (r WebAppStickySettingsResource) ResourceType() string {
+	return "azurerm_web_app_sticky_settings"
+}
+
+func (r WebAppStickySettingsResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.WebAppStickySettingsID
+}
+
+func (r WebAppStickySettingsResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"web_app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Description:  "The ID of the Web App whose Sticky Settings should be managed.",
+			ValidateFunc: validate.WebAppID,
+		},
+
+		"app_setting_names": {
+			Type:        pluginsdk.TypeList,
+			Optional:    true,
+			Description: "A list of `app_setting` names that the Web App will not swap between Slots when a swap operation is triggered.",
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			AtLeastOneOf: []string{
+				"app_setting_names",
+				"connection_string_names",
+			},
+		},
+
+		"connection_string_names": {
+			Type:        pluginsdk.TypeList,
+			Optional:    true,
+			Description: "A list of `connection_string` names that the Web App will not swap between Slots when a swap operation is triggered.",
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			AtLeastOneOf: []string{
+				"app_setting_names",
+				"connection_string_names",
+			},
+		},
+	}
+}
+
+func (r WebAppStickySettingsResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r WebAppStickySettingsResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var stickySettings WebAppStickySettingsModel
+			if err := metadata.Decode(&stickySettings); err != nil {
+				return err
+			}
+
+			client := metadata.Client.AppService.WebAppsClient
+			webAppId, err := parse.WebAppID(stickySettings.WebAppId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewWebAppStickySettingsID(webAppId.SubscriptionId, webAppId.ResourceGroup, webAppId.SiteName, "slotConfigNames")
+
+			app, err := client.Get(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				if utils.ResponseWasNotFound(app.Response) {
+					return fmt.Errorf("%s was not found", webAppId)
+				}
+				return fmt.Errorf("reading %s: %+v", webAppId, err)
+			}
+
+			locks.ByID(webAppId.ID())
+			defer locks.UnlockByID(webAppId.ID())
+
+			existing, err := client.ListSlotConfigurationNames(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				return fmt.Errorf("reading %s: %+v", id, err)
+			}
+			if len(helpers.FlattenStickySettings(existing.SlotConfigNames)) != 0 {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			update := web.SlotConfigNamesResource{
+				SlotConfigNames: expandWebAppStickySettings(stickySettings),
+			}
+			if _, err := client.UpdateSlotConfigurationNames(ctx, id.ResourceGroup, id.SiteName, update); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r WebAppStickySettingsResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parse.WebAppStickySettingsID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ListSlotConfigurationNames(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("reading %s: %+v", id, err)
+			}
+
+			state := WebAppStickySettingsModel{
+				WebAppId: parse.NewWebAppID(id.SubscriptionId, id.ResourceGroup, id.SiteName).ID(),
+			}
+
+			if props := resp.SlotConfigNames; props != nil {
+				if props.AppSettingNames != nil {
+					state.AppSettingNames = *props.AppSettingNames
+				}
+				if props.ConnectionStringNames != nil {
+					state.ConnectionStringNames = *props.ConnectionStringNames
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r WebAppStickySettingsResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parse.WebAppStickySettingsID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var stickySettings WebAppStickySettingsModel
+			if err := metadata.Decode(&stickySettings); err != nil {
+				return err
+			}
+
+			webAppId := parse.NewWebAppID(id.SubscriptionId, id.ResourceGroup, id.SiteName)
+			locks.ByID(webAppId.ID())
+			defer locks.UnlockByID(webAppId.ID())
+
+			update := web.SlotConfigNamesResource{
+				SlotConfigNames: expandWebAppStickySettings(stickySettings),
+			}
+			if _, err := client.UpdateSlotConfigurationNames(ctx, id.ResourceGroup, id.SiteName, update); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r WebAppStickySettingsResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parse.WebAppStickySettingsID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			webAppId := parse.NewWebAppID(id.SubscriptionId, id.ResourceGroup, id.SiteName)
+			locks.ByID(webAppId.ID())
+			defer locks.UnlockByID(webAppId.ID())
+
+			// Sticky Settings cannot be deleted, only emptied
+			update := web.SlotConfigNamesResource{
+				SlotConfigNames: &web.SlotConfigNames{
+					AppSettingNames:       &[]string{},
+					ConnectionStringNames: &[]string{},
+				},
+			}
+			if resp, err := client.UpdateSlotConfigurationNames(ctx, id.ResourceGroup, id.SiteName, update); err != nil {
+				if !utils.ResponseWasNotFound(resp.Response) {
+					return fmt.Errorf("removing %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandWebAppStickySettings(input WebAppStickySettingsModel) *web.SlotConfigNames {
+	appSettingNames := make([]string, 0)
+	if input.AppSettingNames != nil {
+		appSettingNames = input.AppSettingNames
+	}
+
+	connectionStringNames := make([]string, 0)
+	if input.ConnectionStringNames != nil {
+		connectionStringNames = input.ConnectionStringNames
+	}
+
+	return &web.SlotConfigNames{
+		AppSettingNames:       &appSettingNames,
+		ConnectionStringNames: &connectionStringNames,
+	}
+}
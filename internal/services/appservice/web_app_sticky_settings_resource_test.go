@@ -0,0 +1,182 @@
+package appservice_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type WebAppStickySettingsResource struct{}
+
+func TestAccWebAppStickySettings_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_sticky_settings", "test")
+	r := WebAppStickySettingsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccWebAppStickySettings_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_sticky_settings", "test")
+	r := WebAppStickySettingsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccWebAppStickySettings_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_sticky_settings", "test")
+	r := WebAppStickySettingsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("app_setting_names.#").HasValue("2"),
+				check.That(data.ResourceName).Key("connection_string_names.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r WebAppStickySettingsResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.WebAppStickySettingsID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.AppService.WebAppsClient.ListSlotConfigurationNames(ctx, id.ResourceGroup, id.SiteName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	props := resp.SlotConfigNames
+	if props == nil {
+		return utils.Bool(false), nil
+	}
+
+	return utils.Bool((props.AppSettingNames != nil && len(*props.AppSettingNames) > 0) || (props.ConnectionStringNames != nil && len(*props.ConnectionStringNames) > 0)), nil
+}
+
+func (r WebAppStickySettingsResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_web_app_sticky_settings" "test" {
+  web_app_id        = azurerm_linux_web_app.test.id
+  app_setting_names = ["foo"]
+}
+`, r.template(data))
+}
+
+func (r WebAppStickySettingsResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_web_app_sticky_settings" "import" {
+  web_app_id        = azurerm_web_app_sticky_settings.test.web_app_id
+  app_setting_names = azurerm_web_app_sticky_settings.test.app_setting_names
+}
+`, r.basic(data))
+}
+
+func (r WebAppStickySettingsResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_web_app_sticky_settings" "test" {
+  web_app_id              = azurerm_linux_web_app.test.id
+  app_setting_names       = ["foo", "secret"]
+  connection_string_names = ["First"]
+}
+`, r.template(data))
+}
+
+func (WebAppStickySettingsResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_service_plan" "test" {
+  name                = "acctestASP-WASS-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  os_type             = "Linux"
+  sku_name            = "S1"
+}
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  app_settings = {
+    foo    = "bar"
+    secret = "sauce"
+  }
+
+  connection_string {
+    name  = "First"
+    value = "first-connection-string"
+    type  = "Custom"
+  }
+
+  site_config {}
+
+  lifecycle {
+    ignore_changes = [sticky_settings]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
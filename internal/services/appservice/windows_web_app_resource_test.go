@@ -1022,6 +1022,30 @@ func TestAccWindowsWebApp_stickySettingsUpdate(t *testing.T) {
 
 // Deployments
 
+func TestAccWindowsWebApp_healthCheckEvictionTime(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_windows_web_app", "test")
+	r := WindowsWebAppResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.healthCheckEvictionTime(data, 5),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("site_config.0.health_check_eviction_time_in_min").HasValue("5"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.healthCheckEvictionTime(data, 8),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("site_config.0.health_check_eviction_time_in_min").HasValue("8"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccWindowsWebApp_zipDeploy(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_windows_web_app", "test")
 	r := WindowsWebAppResource{}
@@ -1153,6 +1177,32 @@ resource "azurerm_windows_web_app" "test" {
 `, r.baseTemplate(data), data.RandomInteger)
 }
 
+func (r WindowsWebAppResource) healthCheckEvictionTime(data acceptance.TestData, evictionTime int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_windows_web_app" "test" {
+  name                = "acctestWA-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  app_settings = {
+    foo = "bar"
+  }
+
+  site_config {
+    health_check_path                 = "/health"
+    health_check_eviction_time_in_min = %d
+  }
+}
+`, r.baseTemplate(data), data.RandomInteger, evictionTime)
+}
+
 func (r WindowsWebAppResource) windowsFreeSku(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
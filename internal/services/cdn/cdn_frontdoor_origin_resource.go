@@ -122,15 +122,12 @@ func resourceCdnFrontDoorOrigin() *pluginsdk.Resource {
 							ValidateFunc: validation.StringLenBetween(1, 140),
 						},
 
+						// NOTE: when the target is an Application Gateway this is the name of the Private Frontend IP Configuration
+						// rather than a fixed value, as such validation of this field happens in the expand function
 						"target_type": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								"blob",
-								"blob_secondary",
-								"sites",
-								"web",
-							}, false),
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
 						},
 					},
 				},
@@ -407,6 +404,14 @@ func expandPrivateLinkSettings(input []interface{}, skuName cdn.SkuName, enableC
 		return nil, fmt.Errorf("either 'private_link' or 'target_type' must be specified")
 	}
 
+	// an Application Gateway uses the name of the Private Frontend IP Configuration as the Group ID, otherwise
+	// the Target Type must be one of the Group IDs supported by Front Door
+	if _, err := privateLinkServiceParse.ApplicationGatewayID(settings["private_link_target_id"].(string)); err != nil && targetType != "" {
+		if !utils.SliceContainsValue(cdnFrontDoorOriginPrivateLinkTargetTypes(), targetType) {
+			return nil, fmt.Errorf("'target_type' must be one of %q when the 'private_link_target_id' is not an Application Gateway, got %q", cdnFrontDoorOriginPrivateLinkTargetTypes(), targetType)
+		}
+	}
+
 	config := input[0].(map[string]interface{})
 
 	resourceId := config["private_link_target_id"].(string)
@@ -424,6 +429,16 @@ func expandPrivateLinkSettings(input []interface{}, skuName cdn.SkuName, enableC
 	}, nil
 }
 
+func cdnFrontDoorOriginPrivateLinkTargetTypes() []string {
+	return []string{
+		"blob",
+		"blob_secondary",
+		"managedEnvironments",
+		"sites",
+		"web",
+	}
+}
+
 func flattenPrivateLinkSettings(input *cdn.SharedPrivateLinkResourceProperties) []interface{} {
 	if input == nil {
 		return []interface{}{}
@@ -169,6 +169,25 @@ func TestAccCdnFrontDoorOrigin_privateLinkLoadBalancer(t *testing.T) {
 	})
 }
 
+func TestAccCdnFrontDoorOrigin_privateLinkApplicationGateway(t *testing.T) {
+	t.Skip("temporarily skipping until the private link is manually approved as part of the test step")
+
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_origin", "test")
+	r := CdnFrontDoorOriginResource{}
+
+	// NOTE: The Private Link will not be approved at this point but it will
+	// be created. There is currently no way to automate the approval process.
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.privateLinkApplicationGateway(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r CdnFrontDoorOriginResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.FrontDoorOriginID(state.ID)
 	if err != nil {
@@ -385,6 +404,120 @@ resource "azurerm_linux_web_app" "test" {
 `, template, data.RandomInteger, data.RandomString)
 }
 
+func (r CdnFrontDoorOriginResource) templatePrivateLinkApplicationGateway(data acceptance.TestData) string {
+	template := r.template(data, "Premium_AzureFrontDoor", false)
+	return fmt.Sprintf(`
+%[1]s
+
+locals {
+  backend_address_pool_name               = "${azurerm_virtual_network.test.name}-beap"
+  frontend_port_name                      = "${azurerm_virtual_network.test.name}-feport"
+  frontend_ip_configuration_name          = "${azurerm_virtual_network.test.name}-feip"
+  frontend_ip_configuration_internal_name = "${azurerm_virtual_network.test.name}-feipint"
+  http_setting_name                       = "${azurerm_virtual_network.test.name}-be-htst"
+  listener_name                           = "${azurerm_virtual_network.test.name}-httplstn"
+  request_routing_rule_name               = "${azurerm_virtual_network.test.name}-rqrt"
+  private_link_configuration_name         = "private_link"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  address_space       = ["10.5.0.0/16"]
+}
+
+resource "azurerm_subnet" "test" {
+  name                                          = "acctestsn-%[2]d"
+  resource_group_name                           = azurerm_resource_group.test.name
+  virtual_network_name                          = azurerm_virtual_network.test.name
+  address_prefixes                              = ["10.5.1.0/24"]
+  enforce_private_link_service_network_policies = true
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpi-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  allocation_method   = "Static"
+  sku                 = "Standard"
+}
+
+resource "azurerm_application_gateway" "test" {
+  name                = "acctestag-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  sku {
+    name     = "Standard_v2"
+    tier     = "Standard_v2"
+    capacity = 2
+  }
+
+  gateway_ip_configuration {
+    name      = "my-gateway-ip-configuration"
+    subnet_id = azurerm_subnet.test.id
+  }
+
+  frontend_port {
+    name = local.frontend_port_name
+    port = 80
+  }
+
+  frontend_ip_configuration {
+    name                 = local.frontend_ip_configuration_name
+    public_ip_address_id = azurerm_public_ip.test.id
+  }
+
+  frontend_ip_configuration {
+    name                            = local.frontend_ip_configuration_internal_name
+    subnet_id                       = azurerm_subnet.test.id
+    private_ip_address_allocation   = "Static"
+    private_ip_address              = "10.5.1.10"
+    private_link_configuration_name = local.private_link_configuration_name
+  }
+
+  private_link_configuration {
+    name = local.private_link_configuration_name
+    ip_configuration {
+      name                          = "primary"
+      subnet_id                     = azurerm_subnet.test.id
+      private_ip_address_allocation = "Dynamic"
+      primary                       = true
+    }
+  }
+
+  backend_address_pool {
+    name = local.backend_address_pool_name
+  }
+
+  backend_http_settings {
+    name                  = local.http_setting_name
+    cookie_based_affinity = "Disabled"
+    port                  = 80
+    protocol              = "Http"
+    request_timeout       = 1
+  }
+
+  http_listener {
+    name                           = local.listener_name
+    frontend_ip_configuration_name = local.frontend_ip_configuration_internal_name
+    frontend_port_name             = local.frontend_port_name
+    protocol                       = "Http"
+  }
+
+  request_routing_rule {
+    name                       = local.request_routing_rule_name
+    rule_type                  = "Basic"
+    http_listener_name         = local.listener_name
+    backend_address_pool_name  = local.backend_address_pool_name
+    backend_http_settings_name = local.http_setting_name
+    priority                   = 10
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (r CdnFrontDoorOriginResource) basic(data acceptance.TestData) string {
 	template := r.template(data, "Standard_AzureFrontDoor", false)
 	return fmt.Sprintf(`
@@ -634,3 +767,33 @@ resource "azurerm_cdn_frontdoor_origin_group" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, loadBalancerDependsOn, data.RandomInteger, profileSku, data.RandomInteger)
 }
+
+func (r CdnFrontDoorOriginResource) privateLinkApplicationGateway(data acceptance.TestData) string {
+	template := r.templatePrivateLinkApplicationGateway(data)
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_cdn_frontdoor_origin" "test" {
+  name                          = "acctest-cdnfdorigin-%d"
+  cdn_frontdoor_origin_group_id = azurerm_cdn_frontdoor_origin_group.test.id
+
+  health_probes_enabled          = true
+  certificate_name_check_enabled = true
+  host_name                      = "10.5.1.10"
+  origin_host_header             = "10.5.1.10"
+  priority                       = 1
+  weight                         = 500
+
+  private_link {
+    request_message        = "Request access for CDN Frontdoor Private Link Origin"
+    target_type            = local.frontend_ip_configuration_internal_name
+    location               = azurerm_resource_group.test.location
+    private_link_target_id = azurerm_application_gateway.test.id
+  }
+}
+`, template, data.RandomInteger)
+}
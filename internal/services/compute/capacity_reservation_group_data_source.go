@@ -0,0 +1,224 @@
+package compute
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceCapacityReservationGroup() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceCapacityReservationGroupRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.CapacityReservationGroupName(),
+			},
+
+			"resource_group_name": commonschema.ResourceGroupNameForDataSource(),
+
+			"location": commonschema.LocationComputed(),
+
+			"zones": commonschema.ZonesMultipleComputed(),
+
+			"capacity_reservation": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"sku_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"capacity": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"utilized_capacity": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"zone": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"allocated_virtual_machine_ids": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"associated_virtual_machine_ids": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"virtual_machine_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"tags": tags.SchemaDataSource(),
+		},
+	}
+}
+
+func dataSourceCapacityReservationGroupRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	groupsClient := meta.(*clients.Client).Compute.CapacityReservationGroupsClient
+	reservationsClient := meta.(*clients.Client).Compute.CapacityReservationsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewCapacityReservationGroupID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	resp, err := groupsClient.Get(ctx, id.ResourceGroup, id.Name, compute.CapacityReservationGroupInstanceViewTypesInstanceView)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("%s was not found", id)
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+	d.Set("zones", utils.FlattenStringSlice(resp.Zones))
+
+	// the utilization of each Capacity Reservation is only available from the instance view of the group
+	allocatedVirtualMachineIds := make(map[string][]interface{})
+	virtualMachineIds := make([]interface{}, 0)
+	if props := resp.CapacityReservationGroupProperties; props != nil {
+		virtualMachineIds = flattenCapacityReservationSubResourceIds(props.VirtualMachinesAssociated)
+
+		if props.InstanceView != nil && props.InstanceView.CapacityReservations != nil {
+			for _, v := range *props.InstanceView.CapacityReservations {
+				if v.Name == nil {
+					continue
+				}
+
+				allocated := make([]interface{}, 0)
+				if v.UtilizationInfo != nil {
+					allocated = flattenCapacityReservationSubResourceIds(v.UtilizationInfo.VirtualMachinesAllocated)
+				}
+				allocatedVirtualMachineIds[strings.ToLower(*v.Name)] = allocated
+			}
+		}
+	}
+	d.Set("virtual_machine_ids", virtualMachineIds)
+
+	reservations := make([]interface{}, 0)
+	iterator, err := reservationsClient.ListByCapacityReservationGroupComplete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("listing Capacity Reservations for %s: %+v", id, err)
+	}
+	for iterator.NotDone() {
+		reservation := iterator.Value()
+
+		name := utils.NormalizeNilableString(reservation.Name)
+		skuName := ""
+		capacity := 0
+		if sku := reservation.Sku; sku != nil {
+			skuName = utils.NormalizeNilableString(sku.Name)
+			if sku.Capacity != nil {
+				capacity = int(*sku.Capacity)
+			}
+		}
+
+		zone := ""
+		if reservation.Zones != nil && len(*reservation.Zones) > 0 {
+			zone = (*reservation.Zones)[0]
+		}
+
+		associated := make([]interface{}, 0)
+		if props := reservation.CapacityReservationProperties; props != nil {
+			associated = flattenCapacityReservationSubResourceIds(props.VirtualMachinesAssociated)
+		}
+
+		allocated, ok := allocatedVirtualMachineIds[strings.ToLower(name)]
+		if !ok {
+			allocated = make([]interface{}, 0)
+		}
+
+		reservations = append(reservations, map[string]interface{}{
+			"id":                             utils.NormalizeNilableString(reservation.ID),
+			"name":                           name,
+			"sku_name":                       skuName,
+			"capacity":                       capacity,
+			"utilized_capacity":              len(allocated),
+			"zone":                           zone,
+			"allocated_virtual_machine_ids":  allocated,
+			"associated_virtual_machine_ids": associated,
+		})
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Capacity Reservations for %s: %+v", id, err)
+		}
+	}
+
+	if err := d.Set("capacity_reservation", reservations); err != nil {
+		return fmt.Errorf("setting `capacity_reservation`: %+v", err)
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func flattenCapacityReservationSubResourceIds(input *[]compute.SubResourceReadOnly) []interface{} {
+	result := make([]interface{}, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		if v.ID != nil {
+			result = append(result, *v.ID)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,42 @@
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type CapacityReservationGroupDataSource struct{}
+
+func TestAccDataSourceCapacityReservationGroup_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_capacity_reservation_group", "test")
+	r := CapacityReservationGroupDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("location").Exists(),
+				check.That(data.ResourceName).Key("capacity_reservation.#").HasValue("1"),
+				check.That(data.ResourceName).Key("capacity_reservation.0.sku_name").HasValue("Standard_F2"),
+				check.That(data.ResourceName).Key("capacity_reservation.0.capacity").HasValue("2"),
+				check.That(data.ResourceName).Key("capacity_reservation.0.utilized_capacity").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (CapacityReservationGroupDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_capacity_reservation_group" "test" {
+  name                = azurerm_capacity_reservation_group.test.name
+  resource_group_name = azurerm_capacity_reservation_group.test.resource_group_name
+
+  depends_on = [azurerm_capacity_reservation.test]
+}
+`, CapacityReservationResource{}.basic(data))
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
 	"github.com/Azure/azure-sdk-for-go/services/marketplaceordering/mgmt/2015-06-01/marketplaceordering"
+	"github.com/Azure/azure-sdk-for-go/services/virtualmachineimagebuilder/mgmt/2021-10-01/virtualmachineimagebuilder"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-11-01/availabilitysets"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-11-01/dedicatedhostgroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-11-01/dedicatedhosts"
@@ -12,33 +13,40 @@ import (
 )
 
 type Client struct {
-	AvailabilitySetsClient           *availabilitysets.AvailabilitySetsClient
-	CapacityReservationsClient       *compute.CapacityReservationsClient
-	CapacityReservationGroupsClient  *compute.CapacityReservationGroupsClient
-	DedicatedHostsClient             *dedicatedhosts.DedicatedHostsClient
-	DedicatedHostGroupsClient        *dedicatedhostgroups.DedicatedHostGroupsClient
-	DisksClient                      *compute.DisksClient
-	DiskAccessClient                 *compute.DiskAccessesClient
-	DiskEncryptionSetsClient         *compute.DiskEncryptionSetsClient
-	GalleriesClient                  *compute.GalleriesClient
-	GalleryApplicationsClient        *compute.GalleryApplicationsClient
-	GalleryApplicationVersionsClient *compute.GalleryApplicationVersionsClient
-	GalleryImagesClient              *compute.GalleryImagesClient
-	GalleryImageVersionsClient       *compute.GalleryImageVersionsClient
-	ImagesClient                     *compute.ImagesClient
-	MarketplaceAgreementsClient      *marketplaceordering.MarketplaceAgreementsClient
-	ProximityPlacementGroupsClient   *proximityplacementgroups.ProximityPlacementGroupsClient
-	SSHPublicKeysClient              *sshpublickeys.SshPublicKeysClient
-	SnapshotsClient                  *compute.SnapshotsClient
-	UsageClient                      *compute.UsageClient
-	VMExtensionImageClient           *compute.VirtualMachineExtensionImagesClient
-	VMExtensionClient                *compute.VirtualMachineExtensionsClient
-	VMScaleSetClient                 *compute.VirtualMachineScaleSetsClient
-	VMScaleSetExtensionsClient       *compute.VirtualMachineScaleSetExtensionsClient
-	VMScaleSetRollingUpgradesClient  *compute.VirtualMachineScaleSetRollingUpgradesClient
-	VMScaleSetVMsClient              *compute.VirtualMachineScaleSetVMsClient
-	VMClient                         *compute.VirtualMachinesClient
-	VMImageClient                    *compute.VirtualMachineImagesClient
+	AvailabilitySetsClient              *availabilitysets.AvailabilitySetsClient
+	CapacityReservationsClient          *compute.CapacityReservationsClient
+	CapacityReservationGroupsClient     *compute.CapacityReservationGroupsClient
+	CommunityGalleryImagesClient        *compute.CommunityGalleryImagesClient
+	CommunityGalleryImageVersionsClient *compute.CommunityGalleryImageVersionsClient
+	DedicatedHostsClient                *dedicatedhosts.DedicatedHostsClient
+	DedicatedHostsRestartClient         *compute.DedicatedHostsClient
+	DedicatedHostGroupsClient           *dedicatedhostgroups.DedicatedHostGroupsClient
+	DisksClient                         *compute.DisksClient
+	DiskAccessClient                    *compute.DiskAccessesClient
+	DiskEncryptionSetsClient            *compute.DiskEncryptionSetsClient
+	GalleriesClient                     *compute.GalleriesClient
+	GalleryApplicationsClient           *compute.GalleryApplicationsClient
+	GalleryApplicationVersionsClient    *compute.GalleryApplicationVersionsClient
+	GalleryImagesClient                 *compute.GalleryImagesClient
+	GalleryImageVersionsClient          *compute.GalleryImageVersionsClient
+	GallerySharingProfileClient         *compute.GallerySharingProfileClient
+	ImageBuilderTemplatesClient         *virtualmachineimagebuilder.VirtualMachineImageTemplatesClient
+	ImagesClient                        *compute.ImagesClient
+	MarketplaceAgreementsClient         *marketplaceordering.MarketplaceAgreementsClient
+	ProximityPlacementGroupsClient      *proximityplacementgroups.ProximityPlacementGroupsClient
+	SSHPublicKeysClient                 *sshpublickeys.SshPublicKeysClient
+	SnapshotsClient                     *compute.SnapshotsClient
+	UsageClient                         *compute.UsageClient
+	VMExtensionImageClient              *compute.VirtualMachineExtensionImagesClient
+	VMExtensionClient                   *compute.VirtualMachineExtensionsClient
+	VMScaleSetClient                    *compute.VirtualMachineScaleSetsClient
+	VMScaleSetExtensionsClient          *compute.VirtualMachineScaleSetExtensionsClient
+	VMScaleSetRollingUpgradesClient     *compute.VirtualMachineScaleSetRollingUpgradesClient
+	VMScaleSetVMsClient                 *compute.VirtualMachineScaleSetVMsClient
+	VMScaleSetVMRunCommandsClient       *compute.VirtualMachineScaleSetVMRunCommandsClient
+	VMClient                            *compute.VirtualMachinesClient
+	VMImageClient                       *compute.VirtualMachineImagesClient
+	VMRunCommandsClient                 *compute.VirtualMachineRunCommandsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -51,9 +59,19 @@ func NewClient(o *common.ClientOptions) *Client {
 	capacityReservationGroupsClient := compute.NewCapacityReservationGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&capacityReservationGroupsClient.Client, o.ResourceManagerAuthorizer)
 
+	communityGalleryImagesClient := compute.NewCommunityGalleryImagesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&communityGalleryImagesClient.Client, o.ResourceManagerAuthorizer)
+
+	communityGalleryImageVersionsClient := compute.NewCommunityGalleryImageVersionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&communityGalleryImageVersionsClient.Client, o.ResourceManagerAuthorizer)
+
 	dedicatedHostsClient := dedicatedhosts.NewDedicatedHostsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&dedicatedHostsClient.Client, o.ResourceManagerAuthorizer)
 
+	// the `dedicatedhosts` package in go-azure-sdk doesn't include the Restart operation, so the track-1 client is used for it
+	dedicatedHostsRestartClient := compute.NewDedicatedHostsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&dedicatedHostsRestartClient.Client, o.ResourceManagerAuthorizer)
+
 	dedicatedHostGroupsClient := dedicatedhostgroups.NewDedicatedHostGroupsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&dedicatedHostGroupsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -81,6 +99,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	galleryImageVersionsClient := compute.NewGalleryImageVersionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&galleryImageVersionsClient.Client, o.ResourceManagerAuthorizer)
 
+	gallerySharingProfileClient := compute.NewGallerySharingProfileClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&gallerySharingProfileClient.Client, o.ResourceManagerAuthorizer)
+
+	imageBuilderTemplatesClient := virtualmachineimagebuilder.NewVirtualMachineImageTemplatesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&imageBuilderTemplatesClient.Client, o.ResourceManagerAuthorizer)
+
 	imagesClient := compute.NewImagesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&imagesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -120,36 +144,49 @@ func NewClient(o *common.ClientOptions) *Client {
 	vmScaleSetVMsClient := compute.NewVirtualMachineScaleSetVMsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vmScaleSetVMsClient.Client, o.ResourceManagerAuthorizer)
 
+	vmScaleSetVMRunCommandsClient := compute.NewVirtualMachineScaleSetVMRunCommandsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&vmScaleSetVMRunCommandsClient.Client, o.ResourceManagerAuthorizer)
+
 	vmClient := compute.NewVirtualMachinesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vmClient.Client, o.ResourceManagerAuthorizer)
 
+	vmRunCommandsClient := compute.NewVirtualMachineRunCommandsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&vmRunCommandsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		AvailabilitySetsClient:           &availabilitySetsClient,
-		CapacityReservationsClient:       &capacityReservationsClient,
-		CapacityReservationGroupsClient:  &capacityReservationGroupsClient,
-		DedicatedHostsClient:             &dedicatedHostsClient,
-		DedicatedHostGroupsClient:        &dedicatedHostGroupsClient,
-		DisksClient:                      &disksClient,
-		DiskAccessClient:                 &diskAccessClient,
-		DiskEncryptionSetsClient:         &diskEncryptionSetsClient,
-		GalleriesClient:                  &galleriesClient,
-		GalleryApplicationsClient:        &galleryApplicationsClient,
-		GalleryApplicationVersionsClient: &galleryApplicationVersionsClient,
-		GalleryImagesClient:              &galleryImagesClient,
-		GalleryImageVersionsClient:       &galleryImageVersionsClient,
-		ImagesClient:                     &imagesClient,
-		MarketplaceAgreementsClient:      &marketplaceAgreementsClient,
-		ProximityPlacementGroupsClient:   &proximityPlacementGroupsClient,
-		SSHPublicKeysClient:              &sshPublicKeysClient,
-		SnapshotsClient:                  &snapshotsClient,
-		UsageClient:                      &usageClient,
-		VMExtensionImageClient:           &vmExtensionImageClient,
-		VMExtensionClient:                &vmExtensionClient,
-		VMScaleSetClient:                 &vmScaleSetClient,
-		VMScaleSetExtensionsClient:       &vmScaleSetExtensionsClient,
-		VMScaleSetRollingUpgradesClient:  &vmScaleSetRollingUpgradesClient,
-		VMScaleSetVMsClient:              &vmScaleSetVMsClient,
-		VMClient:                         &vmClient,
-		VMImageClient:                    &vmImageClient,
+		AvailabilitySetsClient:              &availabilitySetsClient,
+		CapacityReservationsClient:          &capacityReservationsClient,
+		CapacityReservationGroupsClient:     &capacityReservationGroupsClient,
+		CommunityGalleryImagesClient:        &communityGalleryImagesClient,
+		CommunityGalleryImageVersionsClient: &communityGalleryImageVersionsClient,
+		DedicatedHostsClient:                &dedicatedHostsClient,
+		DedicatedHostsRestartClient:         &dedicatedHostsRestartClient,
+		DedicatedHostGroupsClient:           &dedicatedHostGroupsClient,
+		DisksClient:                         &disksClient,
+		DiskAccessClient:                    &diskAccessClient,
+		DiskEncryptionSetsClient:            &diskEncryptionSetsClient,
+		GalleriesClient:                     &galleriesClient,
+		GalleryApplicationsClient:           &galleryApplicationsClient,
+		GalleryApplicationVersionsClient:    &galleryApplicationVersionsClient,
+		GalleryImagesClient:                 &galleryImagesClient,
+		GalleryImageVersionsClient:          &galleryImageVersionsClient,
+		GallerySharingProfileClient:         &gallerySharingProfileClient,
+		ImageBuilderTemplatesClient:         &imageBuilderTemplatesClient,
+		ImagesClient:                        &imagesClient,
+		MarketplaceAgreementsClient:         &marketplaceAgreementsClient,
+		ProximityPlacementGroupsClient:      &proximityPlacementGroupsClient,
+		SSHPublicKeysClient:                 &sshPublicKeysClient,
+		SnapshotsClient:                     &snapshotsClient,
+		UsageClient:                         &usageClient,
+		VMExtensionImageClient:              &vmExtensionImageClient,
+		VMExtensionClient:                   &vmExtensionClient,
+		VMScaleSetClient:                    &vmScaleSetClient,
+		VMScaleSetExtensionsClient:          &vmScaleSetExtensionsClient,
+		VMScaleSetRollingUpgradesClient:     &vmScaleSetRollingUpgradesClient,
+		VMScaleSetVMsClient:                 &vmScaleSetVMsClient,
+		VMScaleSetVMRunCommandsClient:       &vmScaleSetVMRunCommandsClient,
+		VMClient:                            &vmClient,
+		VMImageClient:                       &vmImageClient,
+		VMRunCommandsClient:                 &vmRunCommandsClient,
 	}
 }
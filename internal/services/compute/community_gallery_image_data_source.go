@@ -0,0 +1,129 @@
+package compute
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceCommunityGalleryImage() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceCommunityGalleryImageRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"gallery_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"location": commonschema.Location(),
+
+			"os_type": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"specialized": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"hyper_v_generation": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"identifier": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"publisher": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"offer": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"sku": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"end_of_life_date": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCommunityGalleryImageRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.CommunityGalleryImagesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	galleryName := d.Get("gallery_name").(string)
+	loc := location.Normalize(d.Get("location").(string))
+
+	resp, err := client.Get(ctx, loc, galleryName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Community Gallery Image %q (Community Gallery %q / Location %q) was not found", name, galleryName, loc)
+		}
+
+		return fmt.Errorf("retrieving Community Gallery Image %q (Community Gallery %q / Location %q): %+v", name, galleryName, loc, err)
+	}
+
+	if resp.CommunityGalleryIdentifier == nil || resp.CommunityGalleryIdentifier.UniqueID == nil {
+		return fmt.Errorf("retrieving Community Gallery Image %q (Community Gallery %q / Location %q): `uniqueId` was nil", name, galleryName, loc)
+	}
+
+	d.SetId(*resp.CommunityGalleryIdentifier.UniqueID)
+
+	d.Set("name", name)
+	d.Set("gallery_name", galleryName)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	if props := resp.CommunityGalleryImageProperties; props != nil {
+		d.Set("os_type", string(props.OsType))
+		d.Set("specialized", props.OsState == compute.OperatingSystemStateTypesSpecialized)
+		d.Set("hyper_v_generation", string(props.HyperVGeneration))
+
+		endOfLifeDate := ""
+		if props.EndOfLifeDate != nil {
+			endOfLifeDate = props.EndOfLifeDate.Format(time.RFC3339)
+		}
+		d.Set("end_of_life_date", endOfLifeDate)
+
+		if err := d.Set("identifier", flattenGalleryImageDataSourceIdentifier(props.Identifier)); err != nil {
+			return fmt.Errorf("setting `identifier`: %+v", err)
+		}
+	}
+
+	return nil
+}
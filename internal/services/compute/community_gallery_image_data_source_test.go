@@ -0,0 +1,52 @@
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type CommunityGalleryImageDataSource struct{}
+
+func TestAccDataSourceCommunityGalleryImage_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_community_gallery_image", "test")
+	r := CommunityGalleryImageDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("os_type").HasValue("Linux"),
+				check.That(data.ResourceName).Key("identifier.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (CommunityGalleryImageDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_shared_image" "test" {
+  name                = "acctestimg%d"
+  gallery_name        = azurerm_shared_image_gallery.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  os_type             = "Linux"
+
+  identifier {
+    publisher = "AccTesPublisher%d"
+    offer     = "AccTesOffer%d"
+    sku       = "AccTesSku%d"
+  }
+}
+
+data "azurerm_community_gallery_image" "test" {
+  name         = azurerm_shared_image.test.name
+  gallery_name = azurerm_shared_image_gallery.test.sharing.0.community_gallery.0.name
+  location     = azurerm_resource_group.test.location
+}
+`, SharedImageGalleryResource{}.communityGallery(data), data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
@@ -0,0 +1,104 @@
+package compute
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceCommunityGalleryImageVersion() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceCommunityGalleryImageVersionRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"image_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"gallery_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"location": commonschema.Location(),
+
+			"published_date": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"end_of_life_date": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCommunityGalleryImageVersionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.CommunityGalleryImageVersionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	imageName := d.Get("image_name").(string)
+	galleryName := d.Get("gallery_name").(string)
+	loc := location.Normalize(d.Get("location").(string))
+
+	resp, err := client.Get(ctx, loc, galleryName, imageName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Community Gallery Image Version %q (Image %q / Community Gallery %q / Location %q) was not found", name, imageName, galleryName, loc)
+		}
+
+		return fmt.Errorf("retrieving Community Gallery Image Version %q (Image %q / Community Gallery %q / Location %q): %+v", name, imageName, galleryName, loc, err)
+	}
+
+	if resp.CommunityGalleryIdentifier == nil || resp.CommunityGalleryIdentifier.UniqueID == nil {
+		return fmt.Errorf("retrieving Community Gallery Image Version %q (Image %q / Community Gallery %q / Location %q): `uniqueId` was nil", name, imageName, galleryName, loc)
+	}
+
+	d.SetId(*resp.CommunityGalleryIdentifier.UniqueID)
+
+	// `latest` resolves to a specific version, so we set the actual name returned by the API
+	d.Set("name", utils.NormalizeNilableString(resp.Name))
+	d.Set("image_name", imageName)
+	d.Set("gallery_name", galleryName)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	if props := resp.CommunityGalleryImageVersionProperties; props != nil {
+		publishedDate := ""
+		if props.PublishedDate != nil {
+			publishedDate = props.PublishedDate.Format(time.RFC3339)
+		}
+		d.Set("published_date", publishedDate)
+
+		endOfLifeDate := ""
+		if props.EndOfLifeDate != nil {
+			endOfLifeDate = props.EndOfLifeDate.Format(time.RFC3339)
+		}
+		d.Set("end_of_life_date", endOfLifeDate)
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
@@ -133,7 +134,51 @@ func resourceDedicatedHost() *pluginsdk.Resource {
 				Default: string(dedicatedhosts.DedicatedHostLicenseTypesNone),
 			},
 
+			"restart_triggers": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
 			"tags": commonschema.Tags(),
+
+			"allocatable_virtual_machine": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"vm_size": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"count": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"asset_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"health_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"virtual_machine_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -193,7 +238,11 @@ func resourceDedicatedHostRead(d *pluginsdk.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	resp, err := hostsClient.Get(ctx, *id, dedicatedhosts.DefaultGetOperationOptions())
+	expand := dedicatedhosts.InstanceViewTypesInstanceView
+	options := dedicatedhosts.GetOperationOptions{
+		Expand: &expand,
+	}
+	resp, err := hostsClient.Get(ctx, *id, options)
 	if err != nil {
 		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[INFO] %s was not found - removing from state", *id)
@@ -219,6 +268,36 @@ func resourceDedicatedHostRead(d *pluginsdk.ResourceData, meta interface{}) erro
 				platformFaultDomain = int(*props.PlatformFaultDomain)
 			}
 			d.Set("platform_fault_domain", platformFaultDomain)
+
+			assetId := ""
+			healthState := ""
+			var allocatableVirtualMachines []interface{}
+			if instanceView := props.InstanceView; instanceView != nil {
+				if instanceView.AssetId != nil {
+					assetId = *instanceView.AssetId
+				}
+				healthState = flattenDedicatedHostHealthState(instanceView.Statuses)
+				if capacity := instanceView.AvailableCapacity; capacity != nil {
+					allocatableVirtualMachines = flattenDedicatedHostAllocatableVirtualMachines(capacity.AllocatableVMs)
+				}
+			}
+			d.Set("asset_id", assetId)
+			d.Set("health_state", healthState)
+			if err := d.Set("allocatable_virtual_machine", allocatableVirtualMachines); err != nil {
+				return fmt.Errorf("setting `allocatable_virtual_machine`: %+v", err)
+			}
+
+			virtualMachineIds := make([]interface{}, 0)
+			if props.VirtualMachines != nil {
+				for _, vm := range *props.VirtualMachines {
+					if vm.Id != nil {
+						virtualMachineIds = append(virtualMachineIds, *vm.Id)
+					}
+				}
+			}
+			if err := d.Set("virtual_machine_ids", virtualMachineIds); err != nil {
+				return fmt.Errorf("setting `virtual_machine_ids`: %+v", err)
+			}
 		}
 
 		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
@@ -256,8 +335,23 @@ func resourceDedicatedHostUpdate(d *pluginsdk.ResourceData, meta interface{}) er
 		payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
-	if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
-		return fmt.Errorf("updating %s: %+v", *id, err)
+	if d.HasChanges("auto_replace_on_failure", "license_type", "tags") {
+		if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+			return fmt.Errorf("updating %s: %+v", *id, err)
+		}
+	}
+
+	if d.HasChange("restart_triggers") {
+		restartClient := meta.(*clients.Client).Compute.DedicatedHostsRestartClient
+		log.Printf("[DEBUG] Restarting %s..", *id)
+		future, err := restartClient.Restart(ctx, id.ResourceGroupName, id.HostGroupName, id.HostName)
+		if err != nil {
+			return fmt.Errorf("restarting %s: %+v", *id, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, restartClient.Client); err != nil {
+			return fmt.Errorf("waiting for restart of %s: %+v", *id, err)
+		}
+		log.Printf("[DEBUG] Restarted %s.", *id)
 	}
 
 	return resourceDedicatedHostRead(d, meta)
@@ -309,3 +403,48 @@ func dedicatedHostDeletedRefreshFunc(ctx context.Context, client *dedicatedhosts
 		return res, "Exists", nil
 	}
 }
+
+func flattenDedicatedHostHealthState(input *[]dedicatedhosts.InstanceViewStatus) string {
+	if input == nil {
+		return ""
+	}
+
+	for _, status := range *input {
+		if status.Code == nil {
+			continue
+		}
+
+		// e.g. `HealthState/available`
+		if strings.HasPrefix(strings.ToLower(*status.Code), "healthstate/") {
+			return (*status.Code)[len("healthstate/"):]
+		}
+	}
+
+	return ""
+}
+
+func flattenDedicatedHostAllocatableVirtualMachines(input *[]dedicatedhosts.DedicatedHostAllocatableVM) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		vmSize := ""
+		if v.VmSize != nil {
+			vmSize = *v.VmSize
+		}
+
+		count := 0
+		if v.Count != nil {
+			count = int(*v.Count)
+		}
+
+		output = append(output, map[string]interface{}{
+			"vm_size": vmSize,
+			"count":   count,
+		})
+	}
+
+	return output
+}
@@ -151,6 +151,30 @@ func TestAccDedicatedHost_update(t *testing.T) {
 	})
 }
 
+func TestAccDedicatedHost_restart(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dedicated_host", "test")
+	r := DedicatedHostResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.restart(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("health_state").Exists(),
+				check.That(data.ResourceName).Key("asset_id").Exists(),
+			),
+		},
+		data.ImportStep("restart_triggers"),
+		{
+			Config: r.restart(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("restart_triggers"),
+	})
+}
+
 func TestAccDedicatedHost_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_dedicated_host", "test")
 	r := DedicatedHostResource{}
@@ -254,6 +278,24 @@ resource "azurerm_dedicated_host" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r DedicatedHostResource) restart(data acceptance.TestData, trigger string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dedicated_host" "test" {
+  name                    = "acctest-DH-%d"
+  location                = azurerm_resource_group.test.location
+  dedicated_host_group_id = azurerm_dedicated_host_group.test.id
+  sku_name                = "DSv3-Type1"
+  platform_fault_domain   = 1
+
+  restart_triggers = {
+    maintenance = %q
+  }
+}
+`, r.template(data), data.RandomInteger, trigger)
+}
+
 func (r DedicatedHostResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -0,0 +1,1161 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/virtualmachineimagebuilder/mgmt/2021-10-01/virtualmachineimagebuilder"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ImageBuilderTemplateResource struct{}
+
+var _ sdk.ResourceWithUpdate = ImageBuilderTemplateResource{}
+
+type ImageBuilderTemplateModel struct {
+	Name                       string                                         `tfschema:"name"`
+	ResourceGroupName          string                                         `tfschema:"resource_group_name"`
+	Location                   string                                         `tfschema:"location"`
+	Identity                   []identity.ModelUserAssigned                   `tfschema:"identity"`
+	SourcePlatformImage        []ImageBuilderTemplatePlatformImageModel       `tfschema:"source_platform_image"`
+	SourceManagedImageId       string                                         `tfschema:"source_managed_image_id"`
+	SourceSharedImageVersionId string                                         `tfschema:"source_shared_image_version_id"`
+	Customizer                 []ImageBuilderTemplateCustomizerModel          `tfschema:"customizer"`
+	ManagedImageDistribution   []ImageBuilderTemplateManagedImageDistribution `tfschema:"managed_image_distribution"`
+	SharedImageDistribution    []ImageBuilderTemplateSharedImageDistribution  `tfschema:"shared_image_distribution"`
+	VhdDistribution            []ImageBuilderTemplateVhdDistributionModel     `tfschema:"vhd_distribution"`
+	BuildTimeoutInMinutes      int                                            `tfschema:"build_timeout_in_minutes"`
+	VMSize                     string                                         `tfschema:"vm_size"`
+	OsDiskSizeGB               int                                            `tfschema:"os_disk_size_gb"`
+	SubnetId                   string                                         `tfschema:"subnet_id"`
+	BuildOnCreateEnabled       bool                                           `tfschema:"build_on_create_enabled"`
+	BuildTriggers              map[string]string                              `tfschema:"build_triggers"`
+	Tags                       map[string]string                              `tfschema:"tags"`
+	LastRunState               string                                         `tfschema:"last_run_state"`
+	LastRunMessage             string                                         `tfschema:"last_run_message"`
+}
+
+type ImageBuilderTemplatePlatformImageModel struct {
+	Publisher     string `tfschema:"publisher"`
+	Offer         string `tfschema:"offer"`
+	Sku           string `tfschema:"sku"`
+	Version       string `tfschema:"version"`
+	PlanName      string `tfschema:"plan_name"`
+	PlanProduct   string `tfschema:"plan_product"`
+	PlanPublisher string `tfschema:"plan_publisher"`
+}
+
+type ImageBuilderTemplateCustomizerModel struct {
+	Type                string   `tfschema:"type"`
+	Name                string   `tfschema:"name"`
+	Inline              []string `tfschema:"inline"`
+	ScriptUri           string   `tfschema:"script_uri"`
+	SourceUri           string   `tfschema:"source_uri"`
+	Destination         string   `tfschema:"destination"`
+	Sha256Checksum      string   `tfschema:"sha256_checksum"`
+	RunElevated         bool     `tfschema:"run_elevated"`
+	RunAsSystem         bool     `tfschema:"run_as_system"`
+	ValidExitCodes      []int    `tfschema:"valid_exit_codes"`
+	RestartCommand      string   `tfschema:"restart_command"`
+	RestartCheckCommand string   `tfschema:"restart_check_command"`
+	RestartTimeout      string   `tfschema:"restart_timeout"`
+	SearchCriteria      string   `tfschema:"search_criteria"`
+	Filters             []string `tfschema:"filters"`
+	UpdateLimit         int      `tfschema:"update_limit"`
+}
+
+type ImageBuilderTemplateManagedImageDistribution struct {
+	ImageId       string            `tfschema:"image_id"`
+	Location      string            `tfschema:"location"`
+	RunOutputName string            `tfschema:"run_output_name"`
+	ArtifactTags  map[string]string `tfschema:"artifact_tags"`
+}
+
+type ImageBuilderTemplateSharedImageDistribution struct {
+	GalleryImageId     string            `tfschema:"gallery_image_id"`
+	ReplicationRegions []string          `tfschema:"replication_regions"`
+	ExcludeFromLatest  bool              `tfschema:"exclude_from_latest"`
+	StorageAccountType string            `tfschema:"storage_account_type"`
+	RunOutputName      string            `tfschema:"run_output_name"`
+	ArtifactTags       map[string]string `tfschema:"artifact_tags"`
+}
+
+type ImageBuilderTemplateVhdDistributionModel struct {
+	RunOutputName string            `tfschema:"run_output_name"`
+	ArtifactTags  map[string]string `tfschema:"artifact_tags"`
+}
+
+func (r ImageBuilderTemplateResource) ResourceType() string {
+	return "azurerm_image_builder_template"
+}
+
+func (r ImageBuilderTemplateResource) ModelObject() interface{} {
+	return &ImageBuilderTemplateModel{}
+}
+
+func (r ImageBuilderTemplateResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ImageBuilderTemplateID
+}
+
+func (r ImageBuilderTemplateResource) Arguments() map[string]*pluginsdk.Schema {
+	sourceProperties := []string{
+		"source_platform_image",
+		"source_managed_image_id",
+		"source_shared_image_version_id",
+	}
+	distributionProperties := []string{
+		"managed_image_distribution",
+		"shared_image_distribution",
+		"vhd_distribution",
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,63}$`),
+				"The name must be between 1 and 64 characters, start with a letter or number and can contain only letters, numbers, underscores, periods and hyphens.",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"identity": commonschema.UserAssignedIdentityRequired(),
+
+		"source_platform_image": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			ExactlyOneOf: sourceProperties,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"publisher": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"offer": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"sku": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"version": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Default:      "latest",
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"plan_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						RequiredWith: []string{
+							"source_platform_image.0.plan_product",
+							"source_platform_image.0.plan_publisher",
+						},
+					},
+
+					"plan_product": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						RequiredWith: []string{
+							"source_platform_image.0.plan_name",
+							"source_platform_image.0.plan_publisher",
+						},
+					},
+
+					"plan_publisher": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						RequiredWith: []string{
+							"source_platform_image.0.plan_name",
+							"source_platform_image.0.plan_product",
+						},
+					},
+				},
+			},
+		},
+
+		"source_managed_image_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ImageID,
+			ExactlyOneOf: sourceProperties,
+		},
+
+		"source_shared_image_version_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.SharedImageVersionID,
+			ExactlyOneOf: sourceProperties,
+		},
+
+		"customizer": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ForceNew: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(virtualmachineimagebuilder.TypeBasicImageTemplateCustomizerTypeFile),
+							string(virtualmachineimagebuilder.TypeBasicImageTemplateCustomizerTypePowerShell),
+							string(virtualmachineimagebuilder.TypeBasicImageTemplateCustomizerTypeShell),
+							string(virtualmachineimagebuilder.TypeBasicImageTemplateCustomizerTypeWindowsRestart),
+							string(virtualmachineimagebuilder.TypeBasicImageTemplateCustomizerTypeWindowsUpdate),
+						}, false),
+					},
+
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					// Shell & PowerShell
+					"inline": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"script_uri": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+
+					// File
+					"source_uri": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+
+					"destination": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					// Shell, PowerShell & File
+					"sha256_checksum": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					// PowerShell
+					"run_elevated": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  false,
+					},
+
+					"run_as_system": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  false,
+					},
+
+					"valid_exit_codes": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeInt,
+						},
+					},
+
+					// WindowsRestart
+					"restart_command": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"restart_check_command": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"restart_timeout": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9]+[smh]$`), "`restart_timeout` must be a number followed by a unit, e.g. `5m` or `2h`."),
+					},
+
+					// WindowsUpdate
+					"search_criteria": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"filters": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"update_limit": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		},
+
+		"managed_image_distribution": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			AtLeastOneOf: distributionProperties,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"image_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validate.ImageID,
+					},
+
+					"location": commonschema.LocationWithoutForceNew(),
+
+					"run_output_name": imageBuilderTemplateRunOutputNameSchema(),
+
+					"artifact_tags": imageBuilderTemplateArtifactTagsSchema(),
+				},
+			},
+		},
+
+		"shared_image_distribution": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			AtLeastOneOf: distributionProperties,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"gallery_image_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validate.SharedImageID,
+					},
+
+					"replication_regions": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						ForceNew: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Schema{
+							Type:             pluginsdk.TypeString,
+							ValidateFunc:     location.EnhancedValidate,
+							StateFunc:        location.StateFunc,
+							DiffSuppressFunc: location.DiffSuppressFunc,
+						},
+					},
+
+					"exclude_from_latest": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  false,
+					},
+
+					"storage_account_type": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						ForceNew: true,
+						Default:  string(virtualmachineimagebuilder.SharedImageStorageAccountTypeStandardLRS),
+						ValidateFunc: validation.StringInSlice([]string{
+							string(virtualmachineimagebuilder.SharedImageStorageAccountTypeStandardLRS),
+							string(virtualmachineimagebuilder.SharedImageStorageAccountTypeStandardZRS),
+						}, false),
+					},
+
+					"run_output_name": imageBuilderTemplateRunOutputNameSchema(),
+
+					"artifact_tags": imageBuilderTemplateArtifactTagsSchema(),
+				},
+			},
+		},
+
+		"vhd_distribution": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			AtLeastOneOf: distributionProperties,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"run_output_name": imageBuilderTemplateRunOutputNameSchema(),
+
+					"artifact_tags": imageBuilderTemplateArtifactTagsSchema(),
+				},
+			},
+		},
+
+		"build_timeout_in_minutes": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      240,
+			ValidateFunc: validation.IntBetween(0, 960),
+		},
+
+		"vm_size": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "Standard_D1_v2",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"os_disk_size_gb": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: networkValidate.SubnetID,
+		},
+
+		"build_on_create_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+			// this is only used when the Image Template is created, so changing it afterwards has no effect
+			DiffSuppressFunc: func(_, _, _ string, d *pluginsdk.ResourceData) bool {
+				return d.Id() != ""
+			},
+		},
+
+		"build_triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r ImageBuilderTemplateResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"last_run_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"last_run_message": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ImageBuilderTemplateResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.ImageBuilderTemplatesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ImageBuilderTemplateModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := parse.NewImageBuilderTemplateID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id.ResourceGroup, id.ImageTemplateName)
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for the presence of existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			expandedIdentity, err := expandImageBuilderTemplateIdentity(model.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			customizers, err := expandImageBuilderTemplateCustomizers(model.Customizer)
+			if err != nil {
+				return err
+			}
+
+			properties := &virtualmachineimagebuilder.ImageTemplateProperties{
+				Source:                expandImageBuilderTemplateSource(model),
+				Customize:             customizers,
+				Distribute:            expandImageBuilderTemplateDistributors(model),
+				BuildTimeoutInMinutes: utils.Int32(int32(model.BuildTimeoutInMinutes)),
+				VMProfile: &virtualmachineimagebuilder.ImageTemplateVMProfile{
+					VMSize: utils.String(model.VMSize),
+				},
+			}
+
+			if model.OsDiskSizeGB != 0 {
+				properties.VMProfile.OsDiskSizeGB = utils.Int32(int32(model.OsDiskSizeGB))
+			}
+
+			if model.SubnetId != "" {
+				properties.VMProfile.VnetConfig = &virtualmachineimagebuilder.VirtualNetworkConfig{
+					SubnetID: utils.String(model.SubnetId),
+				}
+			}
+
+			template := virtualmachineimagebuilder.ImageTemplate{
+				Location:                utils.String(location.Normalize(model.Location)),
+				Identity:                expandedIdentity,
+				ImageTemplateProperties: properties,
+				Tags:                    tags.FromTypedObject(model.Tags),
+			}
+
+			future, err := client.CreateOrUpdate(ctx, template, id.ResourceGroup, id.ImageTemplateName)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if model.BuildOnCreateEnabled {
+				if err := runImageBuilderTemplate(ctx, client, id); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ImageBuilderTemplateResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.ImageBuilderTemplatesClient
+
+			id, err := parse.ImageBuilderTemplateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.ImageTemplateName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ImageBuilderTemplateModel{
+				Name:              id.ImageTemplateName,
+				ResourceGroupName: id.ResourceGroup,
+				Location:          location.NormalizeNilable(resp.Location),
+				Tags:              tags.ToTypedObject(resp.Tags),
+			}
+
+			// these are only used by Terraform to determine when to run a build, so aren't returned by the API
+			state.BuildOnCreateEnabled = metadata.ResourceData.Get("build_on_create_enabled").(bool)
+			state.BuildTriggers = make(map[string]string)
+			for k, v := range metadata.ResourceData.Get("build_triggers").(map[string]interface{}) {
+				state.BuildTriggers[k] = v.(string)
+			}
+
+			flattenedIdentity, err := flattenImageBuilderTemplateIdentity(resp.Identity)
+			if err != nil {
+				return fmt.Errorf("flattening `identity`: %+v", err)
+			}
+			state.Identity = flattenedIdentity
+
+			if props := resp.ImageTemplateProperties; props != nil {
+				flattenImageBuilderTemplateSource(props.Source, &state)
+				state.Customizer = flattenImageBuilderTemplateCustomizers(props.Customize)
+				flattenImageBuilderTemplateDistributors(props.Distribute, &state)
+
+				if props.BuildTimeoutInMinutes != nil {
+					state.BuildTimeoutInMinutes = int(*props.BuildTimeoutInMinutes)
+				}
+
+				if profile := props.VMProfile; profile != nil {
+					state.VMSize = utils.NormalizeNilableString(profile.VMSize)
+					if profile.OsDiskSizeGB != nil {
+						state.OsDiskSizeGB = int(*profile.OsDiskSizeGB)
+					}
+					if profile.VnetConfig != nil {
+						state.SubnetId = utils.NormalizeNilableString(profile.VnetConfig.SubnetID)
+					}
+				}
+
+				if lastRun := props.LastRunStatus; lastRun != nil {
+					state.LastRunState = string(lastRun.RunState)
+					state.LastRunMessage = utils.NormalizeNilableString(lastRun.Message)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ImageBuilderTemplateResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.ImageBuilderTemplatesClient
+
+			id, err := parse.ImageBuilderTemplateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ImageBuilderTemplateModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChanges("identity", "tags") {
+				expandedIdentity, err := expandImageBuilderTemplateIdentity(model.Identity)
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+
+				// only the `identity` and `tags` of an Image Template can be updated, everything else requires recreation
+				params := virtualmachineimagebuilder.ImageTemplateUpdateParameters{
+					Identity: expandedIdentity,
+					Tags:     tags.FromTypedObject(model.Tags),
+				}
+
+				future, err := client.Update(ctx, params, id.ResourceGroup, id.ImageTemplateName)
+				if err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+				if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+					return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("build_triggers") {
+				if err := runImageBuilderTemplate(ctx, client, *id); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ImageBuilderTemplateResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.ImageBuilderTemplatesClient
+
+			id, err := parse.ImageBuilderTemplateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			future, err := client.Delete(ctx, id.ResourceGroup, id.ImageTemplateName)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func imageBuilderTemplateRunOutputNameSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9-_.]{1,64}$`), "`run_output_name` must be between 1 and 64 characters and can contain only letters, numbers, underscores, periods and hyphens."),
+	}
+}
+
+func imageBuilderTemplateArtifactTagsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeMap,
+		Optional: true,
+		ForceNew: true,
+		Elem: &pluginsdk.Schema{
+			Type: pluginsdk.TypeString,
+		},
+	}
+}
+
+func runImageBuilderTemplate(ctx context.Context, client *virtualmachineimagebuilder.VirtualMachineImageTemplatesClient, id parse.ImageBuilderTemplateId) error {
+	future, err := client.Run(ctx, id.ResourceGroup, id.ImageTemplateName)
+	if err != nil {
+		return fmt.Errorf("running build for %s: %+v", id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for build of %s to complete: %+v", id, err)
+	}
+
+	return nil
+}
+
+func expandImageBuilderTemplateIdentity(input []identity.ModelUserAssigned) (*virtualmachineimagebuilder.ImageTemplateIdentity, error) {
+	expanded, err := identity.ExpandUserAssignedMapFromModel(input)
+	if err != nil {
+		return nil, err
+	}
+
+	result := virtualmachineimagebuilder.ImageTemplateIdentity{
+		Type: virtualmachineimagebuilder.ResourceIdentityType(string(expanded.Type)),
+	}
+	if len(expanded.IdentityIds) > 0 {
+		result.UserAssignedIdentities = map[string]*virtualmachineimagebuilder.ImageTemplateIdentityUserAssignedIdentitiesValue{}
+		for k := range expanded.IdentityIds {
+			result.UserAssignedIdentities[k] = &virtualmachineimagebuilder.ImageTemplateIdentityUserAssignedIdentitiesValue{}
+		}
+	}
+
+	return &result, nil
+}
+
+func flattenImageBuilderTemplateIdentity(input *virtualmachineimagebuilder.ImageTemplateIdentity) ([]identity.ModelUserAssigned, error) {
+	var transform *identity.UserAssignedMap
+
+	if input != nil {
+		transform = &identity.UserAssignedMap{
+			Type:        identity.Type(string(input.Type)),
+			IdentityIds: make(map[string]identity.UserAssignedIdentityDetails),
+		}
+		for k, v := range input.UserAssignedIdentities {
+			details := identity.UserAssignedIdentityDetails{}
+			if v != nil {
+				details.ClientId = v.ClientID
+				details.PrincipalId = v.PrincipalID
+			}
+			transform.IdentityIds[k] = details
+		}
+	}
+
+	result, err := identity.FlattenUserAssignedMapToModel(transform)
+	if err != nil {
+		return nil, err
+	}
+
+	return *result, nil
+}
+
+func expandImageBuilderTemplateSource(input ImageBuilderTemplateModel) virtualmachineimagebuilder.BasicImageTemplateSource {
+	if input.SourceManagedImageId != "" {
+		return virtualmachineimagebuilder.ImageTemplateManagedImageSource{
+			ImageID: utils.String(input.SourceManagedImageId),
+		}
+	}
+
+	if input.SourceSharedImageVersionId != "" {
+		return virtualmachineimagebuilder.ImageTemplateSharedImageVersionSource{
+			ImageVersionID: utils.String(input.SourceSharedImageVersionId),
+		}
+	}
+
+	if len(input.SourcePlatformImage) == 0 {
+		return nil
+	}
+
+	platformImage := input.SourcePlatformImage[0]
+	source := virtualmachineimagebuilder.ImageTemplatePlatformImageSource{
+		Publisher: utils.String(platformImage.Publisher),
+		Offer:     utils.String(platformImage.Offer),
+		Sku:       utils.String(platformImage.Sku),
+		Version:   utils.String(platformImage.Version),
+	}
+	if platformImage.PlanName != "" {
+		source.PlanInfo = &virtualmachineimagebuilder.PlatformImagePurchasePlan{
+			PlanName:      utils.String(platformImage.PlanName),
+			PlanProduct:   utils.String(platformImage.PlanProduct),
+			PlanPublisher: utils.String(platformImage.PlanPublisher),
+		}
+	}
+
+	return source
+}
+
+func flattenImageBuilderTemplateSource(input virtualmachineimagebuilder.BasicImageTemplateSource, state *ImageBuilderTemplateModel) {
+	if input == nil {
+		return
+	}
+
+	if source, ok := input.AsImageTemplateManagedImageSource(); ok && source != nil {
+		state.SourceManagedImageId = utils.NormalizeNilableString(source.ImageID)
+		return
+	}
+
+	if source, ok := input.AsImageTemplateSharedImageVersionSource(); ok && source != nil {
+		state.SourceSharedImageVersionId = utils.NormalizeNilableString(source.ImageVersionID)
+		return
+	}
+
+	if source, ok := input.AsImageTemplatePlatformImageSource(); ok && source != nil {
+		platformImage := ImageBuilderTemplatePlatformImageModel{
+			Publisher: utils.NormalizeNilableString(source.Publisher),
+			Offer:     utils.NormalizeNilableString(source.Offer),
+			Sku:       utils.NormalizeNilableString(source.Sku),
+			Version:   utils.NormalizeNilableString(source.Version),
+		}
+		if plan := source.PlanInfo; plan != nil {
+			platformImage.PlanName = utils.NormalizeNilableString(plan.PlanName)
+			platformImage.PlanProduct = utils.NormalizeNilableString(plan.PlanProduct)
+			platformImage.PlanPublisher = utils.NormalizeNilableString(plan.PlanPublisher)
+		}
+		state.SourcePlatformImage = []ImageBuilderTemplatePlatformImageModel{platformImage}
+	}
+}
+
+func expandImageBuilderTemplateCustomizers(input []ImageBuilderTemplateCustomizerModel) (*[]virtualmachineimagebuilder.BasicImageTemplateCustomizer, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	result := make([]virtualmachineimagebuilder.BasicImageTemplateCustomizer, 0)
+	for i, v := range input {
+		var name *string
+		if v.Name != "" {
+			name = utils.String(v.Name)
+		}
+		var sha256Checksum *string
+		if v.Sha256Checksum != "" {
+			sha256Checksum = utils.String(v.Sha256Checksum)
+		}
+
+		switch virtualmachineimagebuilder.TypeBasicImageTemplateCustomizer(v.Type) {
+		case virtualmachineimagebuilder.TypeBasicImageTemplateCustomizerTypeShell:
+			if (len(v.Inline) == 0) == (v.ScriptUri == "") {
+				return nil, fmt.Errorf("exactly one of `inline` or `script_uri` must be specified for the `%s` customizer at index %d", v.Type, i)
+			}
+			customizer := virtualmachineimagebuilder.ImageTemplateShellCustomizer{
+				Name:           name,
+				Sha256Checksum: sha256Checksum,
+			}
+			if len(v.Inline) > 0 {
+				inline := v.Inline
+				customizer.Inline = &inline
+			}
+			if v.ScriptUri != "" {
+				customizer.ScriptURI = utils.String(v.ScriptUri)
+			}
+			result = append(result, customizer)
+
+		case virtualmachineimagebuilder.TypeBasicImageTemplateCustomizerTypePowerShell:
+			if (len(v.Inline) == 0) == (v.ScriptUri == "") {
+				return nil, fmt.Errorf("exactly one of `inline` or `script_uri` must be specified for the `%s` customizer at index %d", v.Type, i)
+			}
+			customizer := virtualmachineimagebuilder.ImageTemplatePowerShellCustomizer{
+				Name:           name,
+				Sha256Checksum: sha256Checksum,
+				RunElevated:    utils.Bool(v.RunElevated),
+				RunAsSystem:    utils.Bool(v.RunAsSystem),
+			}
+			if len(v.Inline) > 0 {
+				inline := v.Inline
+				customizer.Inline = &inline
+			}
+			if v.ScriptUri != "" {
+				customizer.ScriptURI = utils.String(v.ScriptUri)
+			}
+			if len(v.ValidExitCodes) > 0 {
+				exitCodes := make([]int32, 0)
+				for _, code := range v.ValidExitCodes {
+					exitCodes = append(exitCodes, int32(code))
+				}
+				customizer.ValidExitCodes = &exitCodes
+			}
+			result = append(result, customizer)
+
+		case virtualmachineimagebuilder.TypeBasicImageTemplateCustomizerTypeFile:
+			if v.SourceUri == "" || v.Destination == "" {
+				return nil, fmt.Errorf("`source_uri` and `destination` must be specified for the `%s` customizer at index %d", v.Type, i)
+			}
+			result = append(result, virtualmachineimagebuilder.ImageTemplateFileCustomizer{
+				Name:           name,
+				SourceURI:      utils.String(v.SourceUri),
+				Destination:    utils.String(v.Destination),
+				Sha256Checksum: sha256Checksum,
+			})
+
+		case virtualmachineimagebuilder.TypeBasicImageTemplateCustomizerTypeWindowsRestart:
+			customizer := virtualmachineimagebuilder.ImageTemplateRestartCustomizer{
+				Name: name,
+			}
+			if v.RestartCommand != "" {
+				customizer.RestartCommand = utils.String(v.RestartCommand)
+			}
+			if v.RestartCheckCommand != "" {
+				customizer.RestartCheckCommand = utils.String(v.RestartCheckCommand)
+			}
+			if v.RestartTimeout != "" {
+				customizer.RestartTimeout = utils.String(v.RestartTimeout)
+			}
+			result = append(result, customizer)
+
+		case virtualmachineimagebuilder.TypeBasicImageTemplateCustomizerTypeWindowsUpdate:
+			customizer := virtualmachineimagebuilder.ImageTemplateWindowsUpdateCustomizer{
+				Name: name,
+			}
+			if v.SearchCriteria != "" {
+				customizer.SearchCriteria = utils.String(v.SearchCriteria)
+			}
+			if len(v.Filters) > 0 {
+				filters := v.Filters
+				customizer.Filters = &filters
+			}
+			if v.UpdateLimit != 0 {
+				customizer.UpdateLimit = utils.Int32(int32(v.UpdateLimit))
+			}
+			result = append(result, customizer)
+		}
+	}
+
+	return &result, nil
+}
+
+func flattenImageBuilderTemplateCustomizers(input *[]virtualmachineimagebuilder.BasicImageTemplateCustomizer) []ImageBuilderTemplateCustomizerModel {
+	result := make([]ImageBuilderTemplateCustomizerModel, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, item := range *input {
+		if v, ok := item.AsImageTemplateShellCustomizer(); ok && v != nil {
+			result = append(result, ImageBuilderTemplateCustomizerModel{
+				Type:           string(virtualmachineimagebuilder.TypeBasicImageTemplateCustomizerTypeShell),
+				Name:           utils.NormalizeNilableString(v.Name),
+				Inline:         flattenImageBuilderTemplateStringSlice(v.Inline),
+				ScriptUri:      utils.NormalizeNilableString(v.ScriptURI),
+				Sha256Checksum: utils.NormalizeNilableString(v.Sha256Checksum),
+			})
+			continue
+		}
+
+		if v, ok := item.AsImageTemplatePowerShellCustomizer(); ok && v != nil {
+			exitCodes := make([]int, 0)
+			if v.ValidExitCodes != nil {
+				for _, code := range *v.ValidExitCodes {
+					exitCodes = append(exitCodes, int(code))
+				}
+			}
+			result = append(result, ImageBuilderTemplateCustomizerModel{
+				Type:           string(virtualmachineimagebuilder.TypeBasicImageTemplateCustomizerTypePowerShell),
+				Name:           utils.NormalizeNilableString(v.Name),
+				Inline:         flattenImageBuilderTemplateStringSlice(v.Inline),
+				ScriptUri:      utils.NormalizeNilableString(v.ScriptURI),
+				Sha256Checksum: utils.NormalizeNilableString(v.Sha256Checksum),
+				RunElevated:    utils.NormaliseNilableBool(v.RunElevated),
+				RunAsSystem:    utils.NormaliseNilableBool(v.RunAsSystem),
+				ValidExitCodes: exitCodes,
+			})
+			continue
+		}
+
+		if v, ok := item.AsImageTemplateFileCustomizer(); ok && v != nil {
+			result = append(result, ImageBuilderTemplateCustomizerModel{
+				Type:           string(virtualmachineimagebuilder.TypeBasicImageTemplateCustomizerTypeFile),
+				Name:           utils.NormalizeNilableString(v.Name),
+				SourceUri:      utils.NormalizeNilableString(v.SourceURI),
+				Destination:    utils.NormalizeNilableString(v.Destination),
+				Sha256Checksum: utils.NormalizeNilableString(v.Sha256Checksum),
+			})
+			continue
+		}
+
+		if v, ok := item.AsImageTemplateRestartCustomizer(); ok && v != nil {
+			result = append(result, ImageBuilderTemplateCustomizerModel{
+				Type:                string(virtualmachineimagebuilder.TypeBasicImageTemplateCustomizerTypeWindowsRestart),
+				Name:                utils.NormalizeNilableString(v.Name),
+				RestartCommand:      utils.NormalizeNilableString(v.RestartCommand),
+				RestartCheckCommand: utils.NormalizeNilableString(v.RestartCheckCommand),
+				RestartTimeout:      utils.NormalizeNilableString(v.RestartTimeout),
+			})
+			continue
+		}
+
+		if v, ok := item.AsImageTemplateWindowsUpdateCustomizer(); ok && v != nil {
+			updateLimit := 0
+			if v.UpdateLimit != nil {
+				updateLimit = int(*v.UpdateLimit)
+			}
+			result = append(result, ImageBuilderTemplateCustomizerModel{
+				Type:           string(virtualmachineimagebuilder.TypeBasicImageTemplateCustomizerTypeWindowsUpdate),
+				Name:           utils.NormalizeNilableString(v.Name),
+				SearchCriteria: utils.NormalizeNilableString(v.SearchCriteria),
+				Filters:        flattenImageBuilderTemplateStringSlice(v.Filters),
+				UpdateLimit:    updateLimit,
+			})
+		}
+	}
+
+	return result
+}
+
+func expandImageBuilderTemplateDistributors(input ImageBuilderTemplateModel) *[]virtualmachineimagebuilder.BasicImageTemplateDistributor {
+	result := make([]virtualmachineimagebuilder.BasicImageTemplateDistributor, 0)
+
+	for _, v := range input.ManagedImageDistribution {
+		result = append(result, virtualmachineimagebuilder.ImageTemplateManagedImageDistributor{
+			ImageID:       utils.String(v.ImageId),
+			Location:      utils.String(location.Normalize(v.Location)),
+			RunOutputName: utils.String(v.RunOutputName),
+			ArtifactTags:  tags.FromTypedObject(v.ArtifactTags),
+		})
+	}
+
+	for _, v := range input.SharedImageDistribution {
+		regions := make([]string, 0)
+		for _, region := range v.ReplicationRegions {
+			regions = append(regions, location.Normalize(region))
+		}
+		result = append(result, virtualmachineimagebuilder.ImageTemplateSharedImageDistributor{
+			GalleryImageID:     utils.String(v.GalleryImageId),
+			ReplicationRegions: &regions,
+			ExcludeFromLatest:  utils.Bool(v.ExcludeFromLatest),
+			StorageAccountType: virtualmachineimagebuilder.SharedImageStorageAccountType(v.StorageAccountType),
+			RunOutputName:      utils.String(v.RunOutputName),
+			ArtifactTags:       tags.FromTypedObject(v.ArtifactTags),
+		})
+	}
+
+	for _, v := range input.VhdDistribution {
+		result = append(result, virtualmachineimagebuilder.ImageTemplateVhdDistributor{
+			RunOutputName: utils.String(v.RunOutputName),
+			ArtifactTags:  tags.FromTypedObject(v.ArtifactTags),
+		})
+	}
+
+	return &result
+}
+
+func flattenImageBuilderTemplateDistributors(input *[]virtualmachineimagebuilder.BasicImageTemplateDistributor, state *ImageBuilderTemplateModel) {
+	managedImages := make([]ImageBuilderTemplateManagedImageDistribution, 0)
+	sharedImages := make([]ImageBuilderTemplateSharedImageDistribution, 0)
+	vhds := make([]ImageBuilderTemplateVhdDistributionModel, 0)
+
+	if input != nil {
+		for _, item := range *input {
+			if v, ok := item.AsImageTemplateManagedImageDistributor(); ok && v != nil {
+				managedImages = append(managedImages, ImageBuilderTemplateManagedImageDistribution{
+					ImageId:       utils.NormalizeNilableString(v.ImageID),
+					Location:      location.NormalizeNilable(v.Location),
+					RunOutputName: utils.NormalizeNilableString(v.RunOutputName),
+					ArtifactTags:  tags.ToTypedObject(v.ArtifactTags),
+				})
+				continue
+			}
+
+			if v, ok := item.AsImageTemplateSharedImageDistributor(); ok && v != nil {
+				sharedImages = append(sharedImages, ImageBuilderTemplateSharedImageDistribution{
+					GalleryImageId:     utils.NormalizeNilableString(v.GalleryImageID),
+					ReplicationRegions: flattenImageBuilderTemplateStringSlice(v.ReplicationRegions),
+					ExcludeFromLatest:  utils.NormaliseNilableBool(v.ExcludeFromLatest),
+					StorageAccountType: string(v.StorageAccountType),
+					RunOutputName:      utils.NormalizeNilableString(v.RunOutputName),
+					ArtifactTags:       tags.ToTypedObject(v.ArtifactTags),
+				})
+				continue
+			}
+
+			if v, ok := item.AsImageTemplateVhdDistributor(); ok && v != nil {
+				vhds = append(vhds, ImageBuilderTemplateVhdDistributionModel{
+					RunOutputName: utils.NormalizeNilableString(v.RunOutputName),
+					ArtifactTags:  tags.ToTypedObject(v.ArtifactTags),
+				})
+			}
+		}
+	}
+
+	state.ManagedImageDistribution = managedImages
+	state.SharedImageDistribution = sharedImages
+	state.VhdDistribution = vhds
+}
+
+func flattenImageBuilderTemplateStringSlice(input *[]string) []string {
+	if input == nil {
+		return make([]string, 0)
+	}
+
+	return *input
+}
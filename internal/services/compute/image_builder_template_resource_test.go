@@ -0,0 +1,359 @@
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ImageBuilderTemplateResource struct{}
+
+func TestAccImageBuilderTemplate_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_image_builder_template", "test")
+	r := ImageBuilderTemplateResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccImageBuilderTemplate_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_image_builder_template", "test")
+	r := ImageBuilderTemplateResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccImageBuilderTemplate_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_image_builder_template", "test")
+	r := ImageBuilderTemplateResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccImageBuilderTemplate_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_image_builder_template", "test")
+	r := ImageBuilderTemplateResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.tags(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccImageBuilderTemplate_build(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_image_builder_template", "test")
+	r := ImageBuilderTemplateResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.build(data, true, "1"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("last_run_state").Exists(),
+			),
+		},
+		// these are only used by Terraform to determine when to run a build, so aren't returned by the API
+		data.ImportStep("build_on_create_enabled", "build_triggers"),
+		{
+			// changing `build_on_create_enabled` after creation is ignored, whilst changing `build_triggers` runs a build
+			Config: r.build(data, false, "2"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("build_triggers.run").HasValue("2"),
+			),
+		},
+		data.ImportStep("build_on_create_enabled", "build_triggers"),
+	})
+}
+
+func (r ImageBuilderTemplateResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ImageBuilderTemplateID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Compute.ImageBuilderTemplatesClient.Get(ctx, id.ResourceGroup, id.ImageTemplateName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r ImageBuilderTemplateResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctest-compute-%[2]d"
+  location = "%[1]s"
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestuai%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_resource_group.test.id
+  role_definition_name = "Contributor"
+  principal_id         = azurerm_user_assigned_identity.test.principal_id
+}
+`, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r ImageBuilderTemplateResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_image_builder_template" "test" {
+  name                = "acctest-ibt-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  source_platform_image {
+    publisher = "Canonical"
+    offer     = "0001-com-ubuntu-server-focal"
+    sku       = "20_04-lts"
+  }
+
+  vhd_distribution {
+    run_output_name = "acctest-vhd"
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ImageBuilderTemplateResource) build(data acceptance.TestData, buildOnCreate bool, trigger string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_image_builder_template" "test" {
+  name                    = "acctest-ibt-%d"
+  resource_group_name     = azurerm_resource_group.test.name
+  location                = azurerm_resource_group.test.location
+  build_on_create_enabled = %t
+
+  build_triggers = {
+    run = "%s"
+  }
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  source_platform_image {
+    publisher = "Canonical"
+    offer     = "0001-com-ubuntu-server-focal"
+    sku       = "20_04-lts"
+  }
+
+  vhd_distribution {
+    run_output_name = "acctest-vhd"
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomInteger, buildOnCreate, trigger)
+}
+
+func (r ImageBuilderTemplateResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_image_builder_template" "import" {
+  name                = azurerm_image_builder_template.test.name
+  resource_group_name = azurerm_image_builder_template.test.resource_group_name
+  location            = azurerm_image_builder_template.test.location
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  source_platform_image {
+    publisher = "Canonical"
+    offer     = "0001-com-ubuntu-server-focal"
+    sku       = "20_04-lts"
+  }
+
+  vhd_distribution {
+    run_output_name = "acctest-vhd"
+  }
+}
+`, r.basic(data))
+}
+
+func (r ImageBuilderTemplateResource) tags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_image_builder_template" "test" {
+  name                = "acctest-ibt-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  source_platform_image {
+    publisher = "Canonical"
+    offer     = "0001-com-ubuntu-server-focal"
+    sku       = "20_04-lts"
+  }
+
+  vhd_distribution {
+    run_output_name = "acctest-vhd"
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ImageBuilderTemplateResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_shared_image_gallery" "test" {
+  name                = "acctestsig%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_shared_image" "test" {
+  name                = "acctest-img-%[2]d"
+  gallery_name        = azurerm_shared_image_gallery.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  os_type             = "Linux"
+
+  identifier {
+    publisher = "AccTesPublisher%[2]d"
+    offer     = "AccTesOffer%[2]d"
+    sku       = "AccTesSku%[2]d"
+  }
+}
+
+resource "azurerm_image_builder_template" "test" {
+  name                     = "acctest-ibt-%[2]d"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  build_timeout_in_minutes = 120
+  vm_size                  = "Standard_D2s_v3"
+  os_disk_size_gb          = 64
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  source_platform_image {
+    publisher = "Canonical"
+    offer     = "0001-com-ubuntu-server-focal"
+    sku       = "20_04-lts"
+    version   = "latest"
+  }
+
+  customizer {
+    type   = "Shell"
+    name   = "update"
+    inline = ["sudo apt-get update", "sudo apt-get upgrade -y"]
+  }
+
+  customizer {
+    type        = "File"
+    name        = "readme"
+    source_uri  = "https://raw.githubusercontent.com/hashicorp/terraform-provider-azurerm/main/README.md"
+    destination = "/tmp/README.md"
+  }
+
+  managed_image_distribution {
+    image_id        = "${azurerm_resource_group.test.id}/providers/Microsoft.Compute/images/acctest-mi-%[2]d"
+    location        = azurerm_resource_group.test.location
+    run_output_name = "acctest-managed"
+
+    artifact_tags = {
+      source = "acctest"
+    }
+  }
+
+  shared_image_distribution {
+    gallery_image_id     = azurerm_shared_image.test.id
+    replication_regions  = [azurerm_resource_group.test.location]
+    exclude_from_latest  = true
+    storage_account_type = "Standard_ZRS"
+    run_output_name      = "acctest-shared"
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomInteger)
+}
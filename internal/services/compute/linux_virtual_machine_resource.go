@@ -1201,7 +1201,8 @@ func resourceLinuxVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interface
 	if d.HasChange("additional_capabilities") {
 		shouldUpdate = true
 
-		if d.HasChange("additional_capabilities.0.ultra_ssd_enabled") {
+		// both of these can only be changed whilst the Virtual Machine is deallocated
+		if d.HasChanges("additional_capabilities.0.ultra_ssd_enabled", "additional_capabilities.0.hibernation_enabled") {
 			shouldShutDown = true
 			shouldDeallocate = true
 		}
@@ -651,7 +651,10 @@ func resourceManagedDiskUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 
 	if d.HasChange("disk_size_gb") {
 		if old, new := d.GetChange("disk_size_gb"); new.(int) > old.(int) {
-			shouldShutDown = true
+			// UltraSSD data disks can be expanded whilst the Virtual Machine they're attached to is running
+			if !managedDiskSupportsNoDowntimeResize(disk) {
+				shouldShutDown = true
+			}
 			diskUpdate.DiskUpdateProperties.DiskSizeGB = utils.Int32(int32(new.(int)))
 		} else {
 			return fmt.Errorf("- New size must be greater than original size. Shrinking disks is not supported on Azure")
@@ -976,3 +979,18 @@ func resourceManagedDiskDelete(d *pluginsdk.ResourceData, meta interface{}) erro
 
 	return nil
 }
+
+// managedDiskSupportsNoDowntimeResize returns whether the Managed Disk can be expanded without shutting down
+// the Virtual Machine it's attached to - which is the case for UltraSSD Data Disks.
+func managedDiskSupportsNoDowntimeResize(disk compute.Disk) bool {
+	if disk.Sku == nil || disk.DiskProperties == nil {
+		return false
+	}
+
+	// OS Disks always require the Virtual Machine to be deallocated
+	if disk.DiskProperties.OsType != "" {
+		return false
+	}
+
+	return strings.EqualFold(string(disk.Sku.Name), string(compute.DiskStorageAccountTypesUltraSSDLRS))
+}
@@ -569,6 +569,13 @@ func OrchestratedVirtualMachineScaleSetAdditionalCapabilitiesSchema() *pluginsdk
 					Default:  false,
 					ForceNew: true,
 				},
+
+				"hibernation_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+					ForceNew: true,
+				},
 			},
 		},
 	}
@@ -844,6 +851,7 @@ func ExpandOrchestratedVirtualMachineScaleSetAdditionalCapabilities(input []inte
 		raw := input[0].(map[string]interface{})
 
 		capabilities.UltraSSDEnabled = utils.Bool(raw["ultra_ssd_enabled"].(bool))
+		capabilities.HibernationEnabled = utils.Bool(raw["hibernation_enabled"].(bool))
 	}
 
 	return &capabilities
@@ -860,9 +868,16 @@ func FlattenOrchestratedVirtualMachineScaleSetAdditionalCapabilities(input *comp
 		ultraSsdEnabled = *input.UltraSSDEnabled
 	}
 
+	hibernationEnabled := false
+
+	if input.HibernationEnabled != nil {
+		hibernationEnabled = *input.HibernationEnabled
+	}
+
 	return []interface{}{
 		map[string]interface{}{
-			"ultra_ssd_enabled": ultraSsdEnabled,
+			"ultra_ssd_enabled":   ultraSsdEnabled,
+			"hibernation_enabled": hibernationEnabled,
 		},
 	}
 }
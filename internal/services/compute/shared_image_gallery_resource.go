@@ -1,6 +1,8 @@
 package compute
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"time"
@@ -14,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -53,6 +56,80 @@ func resourceSharedImageGallery() *pluginsdk.Resource {
 				Optional: true,
 			},
 
+			"sharing": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"permission": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(compute.GallerySharingPermissionTypesGroups),
+								galleryCommunitySharingPermission,
+							}, false),
+						},
+
+						"community_gallery": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"eula": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"prefix": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"publisher_email": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"publisher_uri": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+									},
+
+									"name": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"subscription_ids": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.IsUUID,
+							},
+						},
+
+						"tenant_ids": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.IsUUID,
+							},
+						},
+					},
+				},
+			},
+
 			"tags": tags.Schema(),
 
 			"unique_name": {
@@ -60,9 +137,31 @@ func resourceSharedImageGallery() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			// sharing can be enabled and disabled in-place, but the API doesn't allow switching an already shared
+			// Gallery between direct sharing and a Community Gallery, or changing the details of a Community Gallery
+			oldPermission, newPermission := d.GetChange("sharing.0.permission")
+			if oldPermission.(string) != "" && newPermission.(string) != "" && oldPermission.(string) != newPermission.(string) {
+				if err := d.ForceNew("sharing.0.permission"); err != nil {
+					return err
+				}
+			}
+
+			if oldPermission.(string) == galleryCommunitySharingPermission && newPermission.(string) == galleryCommunitySharingPermission && d.HasChange("sharing.0.community_gallery") {
+				if err := d.ForceNew("sharing.0.community_gallery"); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}),
 	}
 }
 
+// the vendored SDK doesn't define the `Community` permission type, although it's supported by the API
+const galleryCommunitySharingPermission = "Community"
+
 func resourceSharedImageGalleryCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Compute.GalleriesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -89,10 +188,31 @@ func resourceSharedImageGalleryCreateUpdate(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
+	sharingProfile, err := expandSharedImageGallerySharingProfile(d.Get("sharing").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	sharingClient := meta.(*clients.Client).Compute.GallerySharingProfileClient
+	oldPermissionRaw, _ := d.GetChange("sharing.0.permission")
+	oldPermission := oldPermissionRaw.(string)
+
+	if !d.IsNewResource() && oldPermission != "" && sharingProfile == nil {
+		// removing the `sharing` block doesn't stop the Gallery from being shared, so the sharing profile has to be reset
+		// explicitly before the Gallery can be made private again
+		if err := updateSharedImageGallerySharingProfile(ctx, sharingClient, id, compute.SharingUpdateOperationTypesReset, nil); err != nil {
+			return err
+		}
+		sharingProfile = &compute.SharingProfile{
+			Permissions: compute.GallerySharingPermissionTypesPrivate,
+		}
+	}
+
 	gallery := compute.Gallery{
 		Location: utils.String(location),
 		GalleryProperties: &compute.GalleryProperties{
-			Description: utils.String(description),
+			Description:    utils.String(description),
+			SharingProfile: sharingProfile,
 		},
 		Tags: tags.Expand(t),
 	}
@@ -106,6 +226,42 @@ func resourceSharedImageGalleryCreateUpdate(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
 	}
 
+	if sharingProfile != nil {
+		if string(sharingProfile.Permissions) == galleryCommunitySharingPermission && oldPermission != galleryCommunitySharingPermission {
+			if err := updateSharedImageGallerySharingProfile(ctx, sharingClient, id, compute.SharingUpdateOperationTypesEnableCommunity, nil); err != nil {
+				return err
+			}
+		}
+
+		if sharingProfile.Permissions == compute.GallerySharingPermissionTypesGroups && d.HasChanges("sharing.0.subscription_ids", "sharing.0.tenant_ids") {
+			for _, v := range []struct {
+				key       string
+				groupType compute.SharingProfileGroupTypes
+			}{
+				{key: "sharing.0.subscription_ids", groupType: compute.SharingProfileGroupTypesSubscriptions},
+				{key: "sharing.0.tenant_ids", groupType: compute.SharingProfileGroupTypesAADTenants},
+			} {
+				oldRaw, newRaw := d.GetChange(v.key)
+				oldIds := oldRaw.(*pluginsdk.Set)
+				newIds := newRaw.(*pluginsdk.Set)
+
+				if removed := oldIds.Difference(newIds); removed.Len() > 0 {
+					groups := []compute.SharingProfileGroup{{Type: v.groupType, Ids: utils.ExpandStringSlice(removed.List())}}
+					if err := updateSharedImageGallerySharingProfile(ctx, sharingClient, id, compute.SharingUpdateOperationTypesRemove, &groups); err != nil {
+						return err
+					}
+				}
+
+				if added := newIds.Difference(oldIds); added.Len() > 0 {
+					groups := []compute.SharingProfileGroup{{Type: v.groupType, Ids: utils.ExpandStringSlice(added.List())}}
+					if err := updateSharedImageGallerySharingProfile(ctx, sharingClient, id, compute.SharingUpdateOperationTypesAdd, &groups); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
 	d.SetId(id.ID())
 
 	return resourceSharedImageGalleryRead(d, meta)
@@ -121,7 +277,7 @@ func resourceSharedImageGalleryRead(d *pluginsdk.ResourceData, meta interface{})
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.GalleryName, "", "")
+	resp, err := client.Get(ctx, id.ResourceGroup, id.GalleryName, compute.SelectPermissionsPermissions, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			log.Printf("[DEBUG] Shared Image Gallery %q (Resource Group %q) was not found - removing from state", id.GalleryName, id.ResourceGroup)
@@ -143,6 +299,14 @@ func resourceSharedImageGalleryRead(d *pluginsdk.ResourceData, meta interface{})
 		if identifier := props.Identifier; identifier != nil {
 			d.Set("unique_name", identifier.UniqueName)
 		}
+
+		sharing, err := flattenSharedImageGallerySharingProfile(props.SharingProfile)
+		if err != nil {
+			return fmt.Errorf("flattening `sharing`: %+v", err)
+		}
+		if err := d.Set("sharing", sharing); err != nil {
+			return fmt.Errorf("setting `sharing`: %+v", err)
+		}
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
@@ -171,3 +335,110 @@ func resourceSharedImageGalleryDelete(d *pluginsdk.ResourceData, meta interface{
 
 	return nil
 }
+
+func updateSharedImageGallerySharingProfile(ctx context.Context, client *compute.GallerySharingProfileClient, id parse.SharedImageGalleryId, operation compute.SharingUpdateOperationTypes, groups *[]compute.SharingProfileGroup) error {
+	future, err := client.Update(ctx, id.ResourceGroup, id.GalleryName, compute.SharingUpdate{
+		OperationType: operation,
+		Groups:        groups,
+	})
+	if err != nil {
+		return fmt.Errorf("updating sharing profile (%s) for %s: %+v", operation, id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of sharing profile (%s) for %s: %+v", operation, id, err)
+	}
+
+	return nil
+}
+
+func expandSharedImageGallerySharingProfile(input []interface{}) (*compute.SharingProfile, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	permission := raw["permission"].(string)
+	communityGallery := raw["community_gallery"].([]interface{})
+	hasGroups := raw["subscription_ids"].(*pluginsdk.Set).Len() > 0 || raw["tenant_ids"].(*pluginsdk.Set).Len() > 0
+
+	result := compute.SharingProfile{
+		Permissions: compute.GallerySharingPermissionTypes(permission),
+	}
+
+	if permission == galleryCommunitySharingPermission {
+		if len(communityGallery) == 0 || communityGallery[0] == nil {
+			return nil, fmt.Errorf("`community_gallery` must be specified when `permission` is `%s`", galleryCommunitySharingPermission)
+		}
+		if hasGroups {
+			return nil, fmt.Errorf("`subscription_ids` and `tenant_ids` can only be specified when `permission` is `%s`", compute.GallerySharingPermissionTypesGroups)
+		}
+
+		v := communityGallery[0].(map[string]interface{})
+		result.CommunityGalleryInfo = compute.CommunityGalleryInfo{
+			Eula:             utils.String(v["eula"].(string)),
+			PublicNamePrefix: utils.String(v["prefix"].(string)),
+			PublisherContact: utils.String(v["publisher_email"].(string)),
+			PublisherURI:     utils.String(v["publisher_uri"].(string)),
+		}
+	} else if len(communityGallery) > 0 {
+		return nil, fmt.Errorf("`community_gallery` can only be specified when `permission` is `%s`", galleryCommunitySharingPermission)
+	}
+
+	return &result, nil
+}
+
+func flattenSharedImageGallerySharingProfile(input *compute.SharingProfile) ([]interface{}, error) {
+	if input == nil || input.Permissions == "" || input.Permissions == compute.GallerySharingPermissionTypesPrivate {
+		return []interface{}{}, nil
+	}
+
+	communityGallery := make([]interface{}, 0)
+	if input.CommunityGalleryInfo != nil {
+		// the SDK models `communityGalleryInfo` as an untyped object, so we round-trip it through JSON
+		raw, err := json.Marshal(input.CommunityGalleryInfo)
+		if err != nil {
+			return nil, fmt.Errorf("serializing `communityGalleryInfo`: %+v", err)
+		}
+
+		var info compute.CommunityGalleryInfo
+		if err := json.Unmarshal(raw, &info); err != nil {
+			return nil, fmt.Errorf("deserializing `communityGalleryInfo`: %+v", err)
+		}
+
+		name := ""
+		if info.PublicNames != nil && len(*info.PublicNames) > 0 {
+			name = (*info.PublicNames)[0]
+		}
+
+		communityGallery = append(communityGallery, map[string]interface{}{
+			"eula":            utils.NormalizeNilableString(info.Eula),
+			"prefix":          utils.NormalizeNilableString(info.PublicNamePrefix),
+			"publisher_email": utils.NormalizeNilableString(info.PublisherContact),
+			"publisher_uri":   utils.NormalizeNilableString(info.PublisherURI),
+			"name":            name,
+		})
+	}
+
+	subscriptionIds := make([]interface{}, 0)
+	tenantIds := make([]interface{}, 0)
+	if input.Groups != nil {
+		for _, group := range *input.Groups {
+			switch group.Type {
+			case compute.SharingProfileGroupTypesSubscriptions:
+				subscriptionIds = append(subscriptionIds, utils.FlattenStringSlice(group.Ids)...)
+			case compute.SharingProfileGroupTypesAADTenants:
+				tenantIds = append(tenantIds, utils.FlattenStringSlice(group.Ids)...)
+			}
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"permission":        string(input.Permissions),
+			"community_gallery": communityGallery,
+			"subscription_ids":  subscriptionIds,
+			"tenant_ids":        tenantIds,
+		},
+	}, nil
+}
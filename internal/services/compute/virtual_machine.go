@@ -32,6 +32,12 @@ func virtualMachineAdditionalCapabilitiesSchema() *pluginsdk.Schema {
 					Optional: true,
 					Default:  false,
 				},
+
+				"hibernation_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
 			},
 		},
 	}
@@ -44,6 +50,7 @@ func expandVirtualMachineAdditionalCapabilities(input []interface{}) *compute.Ad
 		raw := input[0].(map[string]interface{})
 
 		capabilities.UltraSSDEnabled = utils.Bool(raw["ultra_ssd_enabled"].(bool))
+		capabilities.HibernationEnabled = utils.Bool(raw["hibernation_enabled"].(bool))
 	}
 
 	return &capabilities
@@ -60,9 +67,16 @@ func flattenVirtualMachineAdditionalCapabilities(input *compute.AdditionalCapabi
 		ultraSsdEnabled = *input.UltraSSDEnabled
 	}
 
+	hibernationEnabled := false
+
+	if input.HibernationEnabled != nil {
+		hibernationEnabled = *input.HibernationEnabled
+	}
+
 	return []interface{}{
 		map[string]interface{}{
-			"ultra_ssd_enabled": ultraSsdEnabled,
+			"ultra_ssd_enabled":   ultraSsdEnabled,
+			"hibernation_enabled": hibernationEnabled,
 		},
 	}
 }
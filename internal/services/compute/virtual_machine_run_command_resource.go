@@ -0,0 +1,518 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VirtualMachineRunCommandResource struct{}
+
+var _ sdk.ResourceWithUpdate = VirtualMachineRunCommandResource{}
+
+type VirtualMachineRunCommandModel struct {
+	Name                  string                                      `tfschema:"name"`
+	VirtualMachineId      string                                      `tfschema:"virtual_machine_id"`
+	Location              string                                      `tfschema:"location"`
+	Source                []VirtualMachineRunCommandSourceModel       `tfschema:"source"`
+	Parameter             []VirtualMachineRunCommandParameterModel    `tfschema:"parameter"`
+	ProtectedParameter    []VirtualMachineRunCommandParameterModel    `tfschema:"protected_parameter"`
+	RunAsUser             string                                      `tfschema:"run_as_user"`
+	RunAsPassword         string                                      `tfschema:"run_as_password"`
+	OutputBlobUri         string                                      `tfschema:"output_blob_uri"`
+	ErrorBlobUri          string                                      `tfschema:"error_blob_uri"`
+	TimeoutInSeconds      int                                         `tfschema:"timeout_in_seconds"`
+	AsyncExecutionEnabled bool                                        `tfschema:"async_execution_enabled"`
+	Tags                  map[string]string                           `tfschema:"tags"`
+	InstanceView          []VirtualMachineRunCommandInstanceViewModel `tfschema:"instance_view"`
+}
+
+type VirtualMachineRunCommandSourceModel struct {
+	Script    string `tfschema:"script"`
+	ScriptUri string `tfschema:"script_uri"`
+	CommandId string `tfschema:"command_id"`
+}
+
+type VirtualMachineRunCommandParameterModel struct {
+	Name  string `tfschema:"name"`
+	Value string `tfschema:"value"`
+}
+
+type VirtualMachineRunCommandInstanceViewModel struct {
+	ExecutionState   string `tfschema:"execution_state"`
+	ExecutionMessage string `tfschema:"execution_message"`
+	ExitCode         int    `tfschema:"exit_code"`
+	Output           string `tfschema:"output"`
+	Error            string `tfschema:"error_message"`
+	StartTime        string `tfschema:"start_time"`
+	EndTime          string `tfschema:"end_time"`
+}
+
+func (r VirtualMachineRunCommandResource) ResourceType() string {
+	return "azurerm_virtual_machine_run_command"
+}
+
+func (r VirtualMachineRunCommandResource) ModelObject() interface{} {
+	return &VirtualMachineRunCommandModel{}
+}
+
+func (r VirtualMachineRunCommandResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.VirtualMachineRunCommandID
+}
+
+func (r VirtualMachineRunCommandResource) Arguments() map[string]*pluginsdk.Schema {
+	arguments := virtualMachineRunCommandArguments()
+	arguments["virtual_machine_id"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validate.VirtualMachineID,
+	}
+	return arguments
+}
+
+func (r VirtualMachineRunCommandResource) Attributes() map[string]*pluginsdk.Schema {
+	return virtualMachineRunCommandAttributes()
+}
+
+func (r VirtualMachineRunCommandResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VMRunCommandsClient
+
+			var model VirtualMachineRunCommandModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			virtualMachineId, err := parse.VirtualMachineID(model.VirtualMachineId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewVirtualMachineRunCommandID(virtualMachineId.SubscriptionId, virtualMachineId.ResourceGroup, virtualMachineId.Name, model.Name)
+			existing, err := client.GetByVirtualMachine(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName, "")
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for the presence of existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			runCommand := compute.VirtualMachineRunCommand{
+				Location:                           utils.String(location.Normalize(model.Location)),
+				VirtualMachineRunCommandProperties: expandVirtualMachineRunCommandProperties(model),
+				Tags:                               tags.FromTypedObject(model.Tags),
+			}
+
+			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName, runCommand)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r VirtualMachineRunCommandResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VMRunCommandsClient
+
+			id, err := parse.VirtualMachineRunCommandID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetByVirtualMachine(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName, "instanceView")
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := VirtualMachineRunCommandModel{
+				Name:             id.RunCommandName,
+				VirtualMachineId: parse.NewVirtualMachineID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineName).ID(),
+				Location:         location.NormalizeNilable(resp.Location),
+				Tags:             tags.ToTypedObject(resp.Tags),
+			}
+
+			// the API doesn't return the protected parameters or the password, so we pull these from the config
+			var config VirtualMachineRunCommandModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			state.ProtectedParameter = config.ProtectedParameter
+			state.RunAsPassword = config.RunAsPassword
+
+			if props := resp.VirtualMachineRunCommandProperties; props != nil {
+				if source := props.Source; source != nil {
+					state.Source = []VirtualMachineRunCommandSourceModel{
+						{
+							Script:    utils.NormalizeNilableString(source.Script),
+							ScriptUri: utils.NormalizeNilableString(source.ScriptURI),
+							CommandId: utils.NormalizeNilableString(source.CommandID),
+						},
+					}
+				}
+
+				state.Parameter = flattenVirtualMachineRunCommandParameters(props.Parameters)
+				state.RunAsUser = utils.NormalizeNilableString(props.RunAsUser)
+				state.OutputBlobUri = utils.NormalizeNilableString(props.OutputBlobURI)
+				state.ErrorBlobUri = utils.NormalizeNilableString(props.ErrorBlobURI)
+				state.AsyncExecutionEnabled = utils.NormaliseNilableBool(props.AsyncExecution)
+
+				if props.TimeoutInSeconds != nil {
+					state.TimeoutInSeconds = int(*props.TimeoutInSeconds)
+				}
+
+				state.InstanceView = flattenVirtualMachineRunCommandInstanceView(props.InstanceView)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r VirtualMachineRunCommandResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VMRunCommandsClient
+
+			id, err := parse.VirtualMachineRunCommandID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model VirtualMachineRunCommandModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the Run Command is re-run when it's updated, so we send the complete payload
+			runCommand := compute.VirtualMachineRunCommand{
+				Location:                           utils.String(location.Normalize(model.Location)),
+				VirtualMachineRunCommandProperties: expandVirtualMachineRunCommandProperties(model),
+				Tags:                               tags.FromTypedObject(model.Tags),
+			}
+
+			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName, runCommand)
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r VirtualMachineRunCommandResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VMRunCommandsClient
+
+			id, err := parse.VirtualMachineRunCommandID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandVirtualMachineRunCommandProperties(input VirtualMachineRunCommandModel) *compute.VirtualMachineRunCommandProperties {
+	props := &compute.VirtualMachineRunCommandProperties{
+		Parameters:          expandVirtualMachineRunCommandParameters(input.Parameter),
+		ProtectedParameters: expandVirtualMachineRunCommandParameters(input.ProtectedParameter),
+		AsyncExecution:      utils.Bool(input.AsyncExecutionEnabled),
+		TimeoutInSeconds:    utils.Int32(int32(input.TimeoutInSeconds)),
+	}
+
+	if len(input.Source) > 0 {
+		source := input.Source[0]
+		props.Source = &compute.VirtualMachineRunCommandScriptSource{}
+		if source.Script != "" {
+			props.Source.Script = utils.String(source.Script)
+		}
+		if source.ScriptUri != "" {
+			props.Source.ScriptURI = utils.String(source.ScriptUri)
+		}
+		if source.CommandId != "" {
+			props.Source.CommandID = utils.String(source.CommandId)
+		}
+	}
+
+	if input.RunAsUser != "" {
+		props.RunAsUser = utils.String(input.RunAsUser)
+	}
+	if input.RunAsPassword != "" {
+		props.RunAsPassword = utils.String(input.RunAsPassword)
+	}
+	if input.OutputBlobUri != "" {
+		props.OutputBlobURI = utils.String(input.OutputBlobUri)
+	}
+	if input.ErrorBlobUri != "" {
+		props.ErrorBlobURI = utils.String(input.ErrorBlobUri)
+	}
+
+	return props
+}
+
+func expandVirtualMachineRunCommandParameters(input []VirtualMachineRunCommandParameterModel) *[]compute.RunCommandInputParameter {
+	result := make([]compute.RunCommandInputParameter, 0)
+	for _, v := range input {
+		result = append(result, compute.RunCommandInputParameter{
+			Name:  utils.String(v.Name),
+			Value: utils.String(v.Value),
+		})
+	}
+
+	return &result
+}
+
+func flattenVirtualMachineRunCommandParameters(input *[]compute.RunCommandInputParameter) []VirtualMachineRunCommandParameterModel {
+	result := make([]VirtualMachineRunCommandParameterModel, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		result = append(result, VirtualMachineRunCommandParameterModel{
+			Name:  utils.NormalizeNilableString(v.Name),
+			Value: utils.NormalizeNilableString(v.Value),
+		})
+	}
+
+	return result
+}
+
+func flattenVirtualMachineRunCommandInstanceView(input *compute.VirtualMachineRunCommandInstanceView) []VirtualMachineRunCommandInstanceViewModel {
+	if input == nil {
+		return []VirtualMachineRunCommandInstanceViewModel{}
+	}
+
+	result := VirtualMachineRunCommandInstanceViewModel{
+		ExecutionState:   string(input.ExecutionState),
+		ExecutionMessage: utils.NormalizeNilableString(input.ExecutionMessage),
+		Output:           utils.NormalizeNilableString(input.Output),
+		Error:            utils.NormalizeNilableString(input.Error),
+	}
+
+	if input.ExitCode != nil {
+		result.ExitCode = int(*input.ExitCode)
+	}
+	if input.StartTime != nil {
+		result.StartTime = input.StartTime.Format(time.RFC3339)
+	}
+	if input.EndTime != nil {
+		result.EndTime = input.EndTime.Format(time.RFC3339)
+	}
+
+	return []VirtualMachineRunCommandInstanceViewModel{result}
+}
+
+func virtualMachineRunCommandArguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"location": commonschema.Location(),
+
+		"source": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"script": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						ExactlyOneOf: []string{"source.0.script", "source.0.script_uri", "source.0.command_id"},
+					},
+
+					"script_uri": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						ExactlyOneOf: []string{"source.0.script", "source.0.script_uri", "source.0.command_id"},
+					},
+
+					"command_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						ExactlyOneOf: []string{"source.0.script", "source.0.script_uri", "source.0.command_id"},
+					},
+				},
+			},
+		},
+
+		"parameter": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"value": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"protected_parameter": {
+			Type:      pluginsdk.TypeList,
+			Optional:  true,
+			Sensitive: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"value": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"run_as_user": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"run_as_password": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			RequiredWith: []string{"run_as_user"},
+		},
+
+		"output_blob_uri": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		},
+
+		"error_blob_uri": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		},
+
+		"timeout_in_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+
+		"async_execution_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func virtualMachineRunCommandAttributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"instance_view": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"execution_state": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"execution_message": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"exit_code": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"output": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"error_message": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"start_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"end_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
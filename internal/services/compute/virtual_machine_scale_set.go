@@ -31,6 +31,13 @@ func VirtualMachineScaleSetAdditionalCapabilitiesSchema() *pluginsdk.Schema {
 					Default:  false,
 					ForceNew: true,
 				},
+
+				"hibernation_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+					ForceNew: true,
+				},
 			},
 		},
 	}
@@ -43,6 +50,7 @@ func ExpandVirtualMachineScaleSetAdditionalCapabilities(input []interface{}) *co
 		raw := input[0].(map[string]interface{})
 
 		capabilities.UltraSSDEnabled = utils.Bool(raw["ultra_ssd_enabled"].(bool))
+		capabilities.HibernationEnabled = utils.Bool(raw["hibernation_enabled"].(bool))
 	}
 
 	return &capabilities
@@ -58,9 +66,15 @@ func FlattenVirtualMachineScaleSetAdditionalCapabilities(input *compute.Addition
 		ultraSsdEnabled = *input.UltraSSDEnabled
 	}
 
+	hibernationEnabled := false
+	if input.HibernationEnabled != nil {
+		hibernationEnabled = *input.HibernationEnabled
+	}
+
 	return []interface{}{
 		map[string]interface{}{
-			"ultra_ssd_enabled": ultraSsdEnabled,
+			"ultra_ssd_enabled":   ultraSsdEnabled,
+			"hibernation_enabled": hibernationEnabled,
 		},
 	}
 }
@@ -0,0 +1,257 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VirtualMachineScaleSetInstanceRunCommandResource struct{}
+
+var _ sdk.ResourceWithUpdate = VirtualMachineScaleSetInstanceRunCommandResource{}
+
+type VirtualMachineScaleSetInstanceRunCommandModel struct {
+	Name                     string                                      `tfschema:"name"`
+	VirtualMachineScaleSetId string                                      `tfschema:"virtual_machine_scale_set_id"`
+	InstanceId               string                                      `tfschema:"instance_id"`
+	Location                 string                                      `tfschema:"location"`
+	Source                   []VirtualMachineRunCommandSourceModel       `tfschema:"source"`
+	Parameter                []VirtualMachineRunCommandParameterModel    `tfschema:"parameter"`
+	ProtectedParameter       []VirtualMachineRunCommandParameterModel    `tfschema:"protected_parameter"`
+	RunAsUser                string                                      `tfschema:"run_as_user"`
+	RunAsPassword            string                                      `tfschema:"run_as_password"`
+	OutputBlobUri            string                                      `tfschema:"output_blob_uri"`
+	ErrorBlobUri             string                                      `tfschema:"error_blob_uri"`
+	TimeoutInSeconds         int                                         `tfschema:"timeout_in_seconds"`
+	AsyncExecutionEnabled    bool                                        `tfschema:"async_execution_enabled"`
+	Tags                     map[string]string                           `tfschema:"tags"`
+	InstanceView             []VirtualMachineRunCommandInstanceViewModel `tfschema:"instance_view"`
+}
+
+func (r VirtualMachineScaleSetInstanceRunCommandResource) ResourceType() string {
+	return "azurerm_virtual_machine_scale_set_instance_run_command"
+}
+
+func (r VirtualMachineScaleSetInstanceRunCommandResource) ModelObject() interface{} {
+	return &VirtualMachineScaleSetInstanceRunCommandModel{}
+}
+
+func (r VirtualMachineScaleSetInstanceRunCommandResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.VirtualMachineScaleSetInstanceRunCommandID
+}
+
+func (r VirtualMachineScaleSetInstanceRunCommandResource) Arguments() map[string]*pluginsdk.Schema {
+	arguments := virtualMachineRunCommandArguments()
+	arguments["virtual_machine_scale_set_id"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validate.VirtualMachineScaleSetID,
+	}
+	arguments["instance_id"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+	return arguments
+}
+
+func (r VirtualMachineScaleSetInstanceRunCommandResource) Attributes() map[string]*pluginsdk.Schema {
+	return virtualMachineRunCommandAttributes()
+}
+
+func (r VirtualMachineScaleSetInstanceRunCommandResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VMScaleSetVMRunCommandsClient
+
+			var model VirtualMachineScaleSetInstanceRunCommandModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			scaleSetId, err := parse.VirtualMachineScaleSetID(model.VirtualMachineScaleSetId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewVirtualMachineScaleSetInstanceRunCommandID(scaleSetId.SubscriptionId, scaleSetId.ResourceGroup, scaleSetId.Name, model.InstanceId, model.Name)
+			existing, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.VirtualMachineName, id.RunCommandName, "")
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for the presence of existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			runCommand := compute.VirtualMachineRunCommand{
+				Location:                           utils.String(location.Normalize(model.Location)),
+				VirtualMachineRunCommandProperties: expandVirtualMachineRunCommandProperties(model.runCommandModel()),
+				Tags:                               tags.FromTypedObject(model.Tags),
+			}
+
+			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.VirtualMachineName, id.RunCommandName, runCommand)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r VirtualMachineScaleSetInstanceRunCommandResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VMScaleSetVMRunCommandsClient
+
+			id, err := parse.VirtualMachineScaleSetInstanceRunCommandID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.VirtualMachineName, id.RunCommandName, "instanceView")
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := VirtualMachineScaleSetInstanceRunCommandModel{
+				Name:                     id.RunCommandName,
+				VirtualMachineScaleSetId: parse.NewVirtualMachineScaleSetID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName).ID(),
+				InstanceId:               id.VirtualMachineName,
+				Location:                 location.NormalizeNilable(resp.Location),
+				Tags:                     tags.ToTypedObject(resp.Tags),
+			}
+
+			// the API doesn't return the protected parameters or the password, so we pull these from the config
+			var config VirtualMachineScaleSetInstanceRunCommandModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			state.ProtectedParameter = config.ProtectedParameter
+			state.RunAsPassword = config.RunAsPassword
+
+			if props := resp.VirtualMachineRunCommandProperties; props != nil {
+				if source := props.Source; source != nil {
+					state.Source = []VirtualMachineRunCommandSourceModel{
+						{
+							Script:    utils.NormalizeNilableString(source.Script),
+							ScriptUri: utils.NormalizeNilableString(source.ScriptURI),
+							CommandId: utils.NormalizeNilableString(source.CommandID),
+						},
+					}
+				}
+
+				state.Parameter = flattenVirtualMachineRunCommandParameters(props.Parameters)
+				state.RunAsUser = utils.NormalizeNilableString(props.RunAsUser)
+				state.OutputBlobUri = utils.NormalizeNilableString(props.OutputBlobURI)
+				state.ErrorBlobUri = utils.NormalizeNilableString(props.ErrorBlobURI)
+				state.AsyncExecutionEnabled = utils.NormaliseNilableBool(props.AsyncExecution)
+
+				if props.TimeoutInSeconds != nil {
+					state.TimeoutInSeconds = int(*props.TimeoutInSeconds)
+				}
+
+				state.InstanceView = flattenVirtualMachineRunCommandInstanceView(props.InstanceView)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r VirtualMachineScaleSetInstanceRunCommandResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VMScaleSetVMRunCommandsClient
+
+			id, err := parse.VirtualMachineScaleSetInstanceRunCommandID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model VirtualMachineScaleSetInstanceRunCommandModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the Run Command is re-run when it's updated, so we send the complete payload
+			runCommand := compute.VirtualMachineRunCommand{
+				Location:                           utils.String(location.Normalize(model.Location)),
+				VirtualMachineRunCommandProperties: expandVirtualMachineRunCommandProperties(model.runCommandModel()),
+				Tags:                               tags.FromTypedObject(model.Tags),
+			}
+
+			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.VirtualMachineName, id.RunCommandName, runCommand)
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r VirtualMachineScaleSetInstanceRunCommandResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VMScaleSetVMRunCommandsClient
+
+			id, err := parse.VirtualMachineScaleSetInstanceRunCommandID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.VirtualMachineName, id.RunCommandName)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// runCommandModel returns the Run Command properties in the shape used by the Virtual Machine Run Command
+// resource, so that both resources can share the same expand functions
+func (m VirtualMachineScaleSetInstanceRunCommandModel) runCommandModel() VirtualMachineRunCommandModel {
+	return VirtualMachineRunCommandModel{
+		Source:                m.Source,
+		Parameter:             m.Parameter,
+		ProtectedParameter:    m.ProtectedParameter,
+		RunAsUser:             m.RunAsUser,
+		RunAsPassword:         m.RunAsPassword,
+		OutputBlobUri:         m.OutputBlobUri,
+		ErrorBlobUri:          m.ErrorBlobUri,
+		TimeoutInSeconds:      m.TimeoutInSeconds,
+		AsyncExecutionEnabled: m.AsyncExecutionEnabled,
+	}
+}
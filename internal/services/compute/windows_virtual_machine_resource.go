@@ -1262,7 +1262,8 @@ func resourceWindowsVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interfa
 	if d.HasChange("additional_capabilities") {
 		shouldUpdate = true
 
-		if d.HasChange("additional_capabilities.0.ultra_ssd_enabled") {
+		// both of these can only be changed whilst the Virtual Machine is deallocated
+		if d.HasChanges("additional_capabilities.0.ultra_ssd_enabled", "additional_capabilities.0.hibernation_enabled") {
 			shouldShutDown = true
 			shouldDeallocate = true
 		}
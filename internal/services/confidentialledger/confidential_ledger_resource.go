@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/confidentialledger/2022-05-13/confidentialledger"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/confidentialledger/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -229,6 +230,10 @@ func resourceConfidentialLedgerUpdate(d *pluginsdk.ResourceData, meta interface{
 		return err
 	}
 
+	// users can also be managed using the `azurerm_confidential_ledger_user` resource, which updates the Ledger under the same lock
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
 	existing, err := client.LedgerGet(ctx, *id)
 	if err != nil {
 		return fmt.Errorf("retrieving existing %s: %+v", *id, err)
@@ -0,0 +1,412 @@
+package confidentialledger
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/confidentialledger/2022-05-13/confidentialledger"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/confidentialledger/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceConfidentialLedgerUser() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceConfidentialLedgerUserCreate,
+		Read:   resourceConfidentialLedgerUserRead,
+		Update: resourceConfidentialLedgerUserUpdate,
+		Delete: resourceConfidentialLedgerUserDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.LedgerUserID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"confidential_ledger_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: confidentialledger.ValidateLedgerID,
+			},
+
+			"ledger_role_name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(confidentialledger.LedgerRoleNameAdministrator),
+					string(confidentialledger.LedgerRoleNameContributor),
+					string(confidentialledger.LedgerRoleNameReader),
+				}, false),
+			},
+
+			"principal_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+				RequiredWith: []string{"tenant_id"},
+				ExactlyOneOf: []string{"principal_id", "pem_public_key"},
+			},
+
+			"tenant_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+				RequiredWith: []string{"principal_id"},
+			},
+
+			"pem_public_key": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"principal_id", "pem_public_key"},
+			},
+		},
+	}
+}
+
+func resourceConfidentialLedgerUserCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ConfidentialLedger.ConfidentialLedgerClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	ledgerId, err := confidentialledger.ParseLedgerID(d.Get("confidential_ledger_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// AzureAD users are identified by their Principal ID, Certificate users by the SHA-256 fingerprint of their certificate
+	userName := d.Get("principal_id").(string)
+	pemPublicKey := d.Get("pem_public_key").(string)
+	if pemPublicKey != "" {
+		userName, err = confidentialLedgerCertificateFingerprint(pemPublicKey)
+		if err != nil {
+			return fmt.Errorf("parsing `pem_public_key`: %+v", err)
+		}
+	}
+	id := parse.NewLedgerUserID(ledgerId.SubscriptionId, ledgerId.ResourceGroupName, ledgerId.LedgerName, userName)
+
+	locks.ByID(ledgerId.ID())
+	defer locks.UnlockByID(ledgerId.ID())
+
+	ledger, err := retrieveConfidentialLedgerForUpdate(ctx, client, *ledgerId)
+	if err != nil {
+		return err
+	}
+
+	if exists, err := confidentialLedgerUserExists(ledger.Properties, id); err != nil {
+		return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+	} else if exists {
+		return tf.ImportAsExistsError("azurerm_confidential_ledger_user", id.ID())
+	}
+
+	ledgerRoleName := confidentialledger.LedgerRoleName(d.Get("ledger_role_name").(string))
+	if pemPublicKey != "" {
+		certBasedUsers := make([]confidentialledger.CertBasedSecurityPrincipal, 0)
+		if existing := ledger.Properties.CertBasedSecurityPrincipals; existing != nil {
+			certBasedUsers = append(certBasedUsers, *existing...)
+		}
+		certBasedUsers = append(certBasedUsers, confidentialledger.CertBasedSecurityPrincipal{
+			Cert:           utils.String(pemPublicKey),
+			LedgerRoleName: &ledgerRoleName,
+		})
+		ledger.Properties.CertBasedSecurityPrincipals = &certBasedUsers
+	} else {
+		aadBasedUsers := make([]confidentialledger.AADBasedSecurityPrincipal, 0)
+		if existing := ledger.Properties.AadBasedSecurityPrincipals; existing != nil {
+			aadBasedUsers = append(aadBasedUsers, *existing...)
+		}
+		aadBasedUsers = append(aadBasedUsers, confidentialledger.AADBasedSecurityPrincipal{
+			LedgerRoleName: &ledgerRoleName,
+			PrincipalId:    utils.String(d.Get("principal_id").(string)),
+			TenantId:       utils.String(d.Get("tenant_id").(string)),
+		})
+		ledger.Properties.AadBasedSecurityPrincipals = &aadBasedUsers
+	}
+
+	if err := client.LedgerUpdateThenPoll(ctx, *ledgerId, *ledger); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceConfidentialLedgerUserRead(d, meta)
+}
+
+func resourceConfidentialLedgerUserRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ConfidentialLedger.ConfidentialLedgerClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LedgerUserID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	ledgerId := confidentialledger.NewLedgerID(id.SubscriptionId, id.ResourceGroup, id.LedgerName)
+	resp, err := client.LedgerGet(ctx, ledgerId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state", ledgerId, *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", ledgerId, err)
+	}
+
+	var props *confidentialledger.LedgerProperties
+	if model := resp.Model; model != nil {
+		props = model.Properties
+	}
+
+	found := false
+	if props != nil && props.AadBasedSecurityPrincipals != nil {
+		for _, item := range *props.AadBasedSecurityPrincipals {
+			if item.PrincipalId == nil || !strings.EqualFold(*item.PrincipalId, id.UserName) {
+				continue
+			}
+
+			found = true
+			d.Set("principal_id", item.PrincipalId)
+			d.Set("tenant_id", item.TenantId)
+			d.Set("pem_public_key", "")
+			d.Set("ledger_role_name", flattenConfidentialLedgerRoleName(item.LedgerRoleName))
+			break
+		}
+	}
+	if !found && props != nil && props.CertBasedSecurityPrincipals != nil {
+		for _, item := range *props.CertBasedSecurityPrincipals {
+			if item.Cert == nil {
+				continue
+			}
+			fingerprint, err := confidentialLedgerCertificateFingerprint(*item.Cert)
+			if err != nil || fingerprint != id.UserName {
+				continue
+			}
+
+			found = true
+			d.Set("principal_id", "")
+			d.Set("tenant_id", "")
+			// the API may return the certificate with different whitespace/line endings, so keep the configured value when it's the same certificate
+			pemPublicKey := *item.Cert
+			if existing, err := confidentialLedgerCertificateFingerprint(d.Get("pem_public_key").(string)); err == nil && existing == fingerprint {
+				pemPublicKey = d.Get("pem_public_key").(string)
+			}
+			d.Set("pem_public_key", pemPublicKey)
+			d.Set("ledger_role_name", flattenConfidentialLedgerRoleName(item.LedgerRoleName))
+			break
+		}
+	}
+	if !found {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("confidential_ledger_id", ledgerId.ID())
+
+	return nil
+}
+
+func resourceConfidentialLedgerUserUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ConfidentialLedger.ConfidentialLedgerClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LedgerUserID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	ledgerId := confidentialledger.NewLedgerID(id.SubscriptionId, id.ResourceGroup, id.LedgerName)
+
+	locks.ByID(ledgerId.ID())
+	defer locks.UnlockByID(ledgerId.ID())
+
+	ledger, err := retrieveConfidentialLedgerForUpdate(ctx, client, ledgerId)
+	if err != nil {
+		return err
+	}
+
+	ledgerRoleName := confidentialledger.LedgerRoleName(d.Get("ledger_role_name").(string))
+	if err := updateConfidentialLedgerUsers(ledger.Properties, *id, &ledgerRoleName); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	if err := client.LedgerUpdateThenPoll(ctx, ledgerId, *ledger); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceConfidentialLedgerUserRead(d, meta)
+}
+
+func resourceConfidentialLedgerUserDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ConfidentialLedger.ConfidentialLedgerClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LedgerUserID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	ledgerId := confidentialledger.NewLedgerID(id.SubscriptionId, id.ResourceGroup, id.LedgerName)
+
+	locks.ByID(ledgerId.ID())
+	defer locks.UnlockByID(ledgerId.ID())
+
+	ledger, err := retrieveConfidentialLedgerForUpdate(ctx, client, ledgerId)
+	if err != nil {
+		return err
+	}
+
+	if err := updateConfidentialLedgerUsers(ledger.Properties, *id, nil); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := client.LedgerUpdateThenPoll(ctx, ledgerId, *ledger); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// retrieveConfidentialLedgerForUpdate returns the Confidential Ledger containing only the fields which can be sent in an update
+func retrieveConfidentialLedgerForUpdate(ctx context.Context, client *confidentialledger.ConfidentialLedgerClient, id confidentialledger.LedgerId) (*confidentialledger.ConfidentialLedger, error) {
+	existing, err := client.LedgerGet(ctx, id)
+	if err != nil {
+		if response.WasNotFound(existing.HttpResponse) {
+			return nil, fmt.Errorf("%s was not found", id)
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if existing.Model == nil {
+		return nil, fmt.Errorf("retrieving %s: model was nil", id)
+	}
+	if existing.Model.Properties == nil {
+		return nil, fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+
+	return &confidentialledger.ConfidentialLedger{
+		Location: existing.Model.Location,
+		Properties: &confidentialledger.LedgerProperties{
+			AadBasedSecurityPrincipals:  existing.Model.Properties.AadBasedSecurityPrincipals,
+			CertBasedSecurityPrincipals: existing.Model.Properties.CertBasedSecurityPrincipals,
+			LedgerType:                  existing.Model.Properties.LedgerType,
+		},
+		Tags: existing.Model.Tags,
+	}, nil
+}
+
+func confidentialLedgerUserExists(props *confidentialledger.LedgerProperties, id parse.LedgerUserId) (bool, error) {
+	if props.AadBasedSecurityPrincipals != nil {
+		for _, item := range *props.AadBasedSecurityPrincipals {
+			if item.PrincipalId != nil && strings.EqualFold(*item.PrincipalId, id.UserName) {
+				return true, nil
+			}
+		}
+	}
+
+	if props.CertBasedSecurityPrincipals != nil {
+		for _, item := range *props.CertBasedSecurityPrincipals {
+			if item.Cert == nil {
+				continue
+			}
+			fingerprint, err := confidentialLedgerCertificateFingerprint(*item.Cert)
+			if err != nil {
+				return false, err
+			}
+			if fingerprint == id.UserName {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// updateConfidentialLedgerUsers sets the Ledger Role of the specified user, or removes the user when `ledgerRoleName` is nil
+func updateConfidentialLedgerUsers(props *confidentialledger.LedgerProperties, id parse.LedgerUserId, ledgerRoleName *confidentialledger.LedgerRoleName) error {
+	if props.AadBasedSecurityPrincipals != nil {
+		aadBasedUsers := make([]confidentialledger.AADBasedSecurityPrincipal, 0)
+		for _, item := range *props.AadBasedSecurityPrincipals {
+			if item.PrincipalId != nil && strings.EqualFold(*item.PrincipalId, id.UserName) {
+				if ledgerRoleName == nil {
+					continue
+				}
+				item.LedgerRoleName = ledgerRoleName
+			}
+			aadBasedUsers = append(aadBasedUsers, item)
+		}
+		props.AadBasedSecurityPrincipals = &aadBasedUsers
+	}
+
+	if props.CertBasedSecurityPrincipals != nil {
+		certBasedUsers := make([]confidentialledger.CertBasedSecurityPrincipal, 0)
+		for _, item := range *props.CertBasedSecurityPrincipals {
+			if item.Cert != nil {
+				fingerprint, err := confidentialLedgerCertificateFingerprint(*item.Cert)
+				if err != nil {
+					return err
+				}
+				if fingerprint == id.UserName {
+					if ledgerRoleName == nil {
+						continue
+					}
+					item.LedgerRoleName = ledgerRoleName
+				}
+			}
+			certBasedUsers = append(certBasedUsers, item)
+		}
+		props.CertBasedSecurityPrincipals = &certBasedUsers
+	}
+
+	return nil
+}
+
+// confidentialLedgerCertificateFingerprint returns the SHA-256 fingerprint of a PEM encoded certificate. The certificate can be
+// provided with or without line breaks, so the encoded value is extracted from between the header and footer manually.
+func confidentialLedgerCertificateFingerprint(input string) (string, error) {
+	encoded := strings.TrimSpace(input)
+	encoded = strings.TrimPrefix(encoded, "-----BEGIN CERTIFICATE-----")
+	encoded = strings.TrimSuffix(encoded, "-----END CERTIFICATE-----")
+	encoded = strings.Join(strings.Fields(encoded), "")
+
+	der, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("decoding certificate: %+v", err)
+	}
+
+	fingerprint := sha256.Sum256(der)
+	return hex.EncodeToString(fingerprint[:]), nil
+}
+
+func flattenConfidentialLedgerRoleName(input *confidentialledger.LedgerRoleName) string {
+	if input == nil {
+		return ""
+	}
+
+	return string(*input)
+}
@@ -0,0 +1,190 @@
+package confidentialledger_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/confidentialledger/2022-05-13/confidentialledger"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/confidentialledger/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ConfidentialLedgerUserResource struct{}
+
+func TestAccConfidentialLedgerUser_azureAD(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_confidential_ledger_user", "test")
+	r := ConfidentialLedgerUserResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.azureAD(data, "Reader"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.azureAD(data, "Contributor"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccConfidentialLedgerUser_certificate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_confidential_ledger_user", "test")
+	r := ConfidentialLedgerUserResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.certificate(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccConfidentialLedgerUser_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_confidential_ledger_user", "test")
+	r := ConfidentialLedgerUserResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.azureAD(data, "Reader"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (ConfidentialLedgerUserResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.LedgerUserID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	ledgerId := confidentialledger.NewLedgerID(id.SubscriptionId, id.ResourceGroup, id.LedgerName)
+	resp, err := clients.ConfidentialLedger.ConfidentialLedgerClient.LedgerGet(ctx, ledgerId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", ledgerId, err)
+	}
+
+	if resp.Model == nil || resp.Model.Properties == nil {
+		return utils.Bool(false), nil
+	}
+
+	if users := resp.Model.Properties.AadBasedSecurityPrincipals; users != nil {
+		for _, user := range *users {
+			if user.PrincipalId != nil && strings.EqualFold(*user.PrincipalId, id.UserName) {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	if users := resp.Model.Properties.CertBasedSecurityPrincipals; users != nil {
+		for _, user := range *users {
+			if user.Cert == nil {
+				continue
+			}
+			// Certificate users are identified by the SHA-256 fingerprint of their certificate
+			encoded := strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(*user.Cert), "-----BEGIN CERTIFICATE-----"), "-----END CERTIFICATE-----")
+			der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(encoded), ""))
+			if err != nil {
+				return nil, fmt.Errorf("decoding certificate for %s: %+v", ledgerId, err)
+			}
+			fingerprint := sha256.Sum256(der)
+			if hex.EncodeToString(fingerprint[:]) == id.UserName {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r ConfidentialLedgerUserResource) azureAD(data acceptance.TestData, role string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_confidential_ledger_user" "test" {
+  confidential_ledger_id = azurerm_confidential_ledger.test.id
+  principal_id           = azurerm_user_assigned_identity.first.principal_id
+  tenant_id              = azurerm_user_assigned_identity.first.tenant_id
+  ledger_role_name       = "%s"
+}
+`, template, role)
+}
+
+func (r ConfidentialLedgerUserResource) certificate(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_confidential_ledger_user" "test" {
+  confidential_ledger_id = azurerm_confidential_ledger.test.id
+  ledger_role_name       = "Reader"
+  pem_public_key         = "-----BEGIN CERTIFICATE-----MIIBsjCCATigAwIBAgIUZWIbyG79TniQLd2UxJuU74tqrKcwCgYIKoZIzj0EAwMwEDEOMAwGA1UEAwwFdXNlcjAwHhcNMjEwMzE2MTgwNjExWhcNMjIwMzE2MTgwNjExWjAQMQ4wDAYDVQQDDAV1c2VyMDB2MBAGByqGSM49AgEGBSuBBAAiA2IABBiWSo/j8EFit7aUMm5lF+lUmCu+IgfnpFD+7QMgLKtxRJ3aGSqgS/GpqcYVGddnODtSarNE/HyGKUFUolLPQ5ybHcouUk0kyfA7XMeSoUA4lBz63Wha8wmXo+NdBRo39qNTMFEwHQYDVR0OBBYEFPtuhrwgGjDFHeUUT4nGsXaZn69KMB8GA1UdIwQYMBaAFPtuhrwgGjDFHeUUT4nGsXaZn69KMA8GA1UdEwEB/wQFMAMBAf8wCgYIKoZIzj0EAwMDaAAwZQIxAOnozm2CyqRwSSQLls5r+mUHRGRyXHXwYtM4Dcst/VEZdmS9fqvHRCHbjUlO/+HNfgIwMWZ4FmsjD3wnPxONOm9YdVn/PRD7SsPRPbOjwBiE4EBGaHDsLjYAGDSGi7NJnSkA-----END CERTIFICATE-----"
+}
+`, template)
+}
+
+func (r ConfidentialLedgerUserResource) requiresImport(data acceptance.TestData) string {
+	template := r.azureAD(data, "Reader")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_confidential_ledger_user" "import" {
+  confidential_ledger_id = azurerm_confidential_ledger_user.test.confidential_ledger_id
+  principal_id           = azurerm_confidential_ledger_user.test.principal_id
+  tenant_id              = azurerm_confidential_ledger_user.test.tenant_id
+  ledger_role_name       = azurerm_confidential_ledger_user.test.ledger_role_name
+}
+`, template)
+}
+
+func (ConfidentialLedgerUserResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_confidential_ledger" "test" {
+  name                = "acctest-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  ledger_type         = "Private"
+
+  azuread_based_service_principal {
+    ledger_role_name = "Administrator"
+    principal_id     = data.azurerm_client_config.current.object_id
+    tenant_id        = data.azurerm_client_config.current.tenant_id
+  }
+
+  lifecycle {
+    ignore_changes = [azuread_based_service_principal, certificate_based_security_principal]
+  }
+}
+`, ConfidentialLedgerResource{}.template(data), data.RandomInteger)
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type LedgerUserId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	LedgerName     string
+	UserName       string
+}
+
+func NewLedgerUserID(subscriptionId, resourceGroup, ledgerName, userName string) LedgerUserId {
+	return LedgerUserId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		LedgerName:     ledgerName,
+		UserName:       userName,
+	}
+}
+
+func (id LedgerUserId) String() string {
+	segments := []string{
+		fmt.Sprintf("User Name %q", id.UserName),
+		fmt.Sprintf("Ledger Name %q", id.LedgerName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Ledger User", segmentsStr)
+}
+
+func (id LedgerUserId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ConfidentialLedger/ledgers/%s/users/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.LedgerName, id.UserName)
+}
+
+// LedgerUserID parses a LedgerUser ID into an LedgerUserId struct
+func LedgerUserID(input string) (*LedgerUserId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := LedgerUserId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.LedgerName, err = id.PopSegment("ledgers"); err != nil {
+		return nil, err
+	}
+	if resourceId.UserName, err = id.PopSegment("users"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
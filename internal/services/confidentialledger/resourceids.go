@@ -0,0 +1,3 @@
+package confidentialledger
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=LedgerUser -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ConfidentialLedger/ledgers/ledger1/users/user1
@@ -54,6 +54,18 @@ func dataSourceDataFactory() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Computed: true,
 						},
+						"client_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"client_secret_key_vault_url": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"client_secret_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
 						"git_url": {
 							Type:     pluginsdk.TypeString,
 							Computed: true,
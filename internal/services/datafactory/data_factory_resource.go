@@ -85,6 +85,24 @@ func resourceDataFactory() *pluginsdk.Resource {
 							Required:     true,
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
+						"client_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							RequiredWith: []string{"github_configuration.0.client_secret_key_vault_url", "github_configuration.0.client_secret_name"},
+						},
+						"client_secret_key_vault_url": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+							RequiredWith: []string{"github_configuration.0.client_id", "github_configuration.0.client_secret_name"},
+						},
+						"client_secret_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							RequiredWith: []string{"github_configuration.0.client_id", "github_configuration.0.client_secret_key_vault_url"},
+						},
 						"git_url": {
 							Type:         pluginsdk.TypeString,
 							Required:     true,
@@ -467,13 +485,21 @@ func expandDataFactoryRepoConfiguration(d *pluginsdk.ResourceData) (bool, datafa
 		gitURL := github["git_url"].(string)
 		repositoryName := github["repository_name"].(string)
 		rootFolder := github["root_folder"].(string)
-		return true, &datafactory.FactoryGitHubConfiguration{
+		config := &datafactory.FactoryGitHubConfiguration{
 			AccountName:         &accountName,
 			CollaborationBranch: &branchName,
 			HostName:            &gitURL,
 			RepositoryName:      &repositoryName,
 			RootFolder:          &rootFolder,
 		}
+		if clientID := github["client_id"].(string); clientID != "" {
+			config.ClientID = utils.String(clientID)
+			config.ClientSecret = &datafactory.GitHubClientSecret{
+				ByoaSecretAkvURL: utils.String(github["client_secret_key_vault_url"].(string)),
+				ByoaSecretName:   utils.String(github["client_secret_name"].(string)),
+			}
+		}
+		return true, config
 	}
 
 	return false, nil
@@ -520,6 +546,17 @@ func flattenDataFactoryRepoConfiguration(factory *datafactory.Factory) (datafact
 				if config.HostName != nil {
 					settings["git_url"] = *config.HostName
 				}
+				if config.ClientID != nil {
+					settings["client_id"] = *config.ClientID
+				}
+				if secret := config.ClientSecret; secret != nil {
+					if secret.ByoaSecretAkvURL != nil {
+						settings["client_secret_key_vault_url"] = *secret.ByoaSecretAkvURL
+					}
+					if secret.ByoaSecretName != nil {
+						settings["client_secret_name"] = *secret.ByoaSecretName
+					}
+				}
 				if config.RepositoryName != nil {
 					settings["repository_name"] = *config.RepositoryName
 				}
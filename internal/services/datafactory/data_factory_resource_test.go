@@ -150,6 +150,32 @@ func TestAccDataFactory_github(t *testing.T) {
 	})
 }
 
+func TestAccDataFactory_githubByoa(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory", "test")
+	r := DataFactoryResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.githubByoa(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("github_configuration.0.client_id").HasValue("Iv1.0123456789abcdef"),
+				check.That(data.ResourceName).Key("github_configuration.0.client_secret_key_vault_url").Exists(),
+				check.That(data.ResourceName).Key("github_configuration.0.client_secret_name").HasValue("github-app-secret"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.github(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("github_configuration.0.client_id").IsEmpty(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccDataFactory_publicNetworkDisabled(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_data_factory", "test")
 	r := DataFactoryResource{}
@@ -520,6 +546,68 @@ resource "azurerm_data_factory" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
 }
 
+func (DataFactoryResource) githubByoa(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    key_vault {
+      purge_soft_delete_on_destroy = false
+    }
+  }
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-df-%d"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acckv%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+
+  access_policy {
+    tenant_id = data.azurerm_client_config.current.tenant_id
+    object_id = data.azurerm_client_config.current.object_id
+
+    secret_permissions = [
+      "Delete",
+      "Get",
+      "Purge",
+      "Set"
+    ]
+  }
+}
+
+resource "azurerm_key_vault_secret" "test" {
+  name         = "github-app-secret"
+  value        = "not-a-real-secret"
+  key_vault_id = azurerm_key_vault.test.id
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestDF%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  github_configuration {
+    git_url                     = "https://github.com/hashicorp/"
+    repository_name             = "terraform-provider-azurerm"
+    branch_name                 = "main"
+    root_folder                 = "/"
+    account_name                = "acctestGH-%d"
+    client_id                   = "Iv1.0123456789abcdef"
+    client_secret_key_vault_url = azurerm_key_vault.test.vault_uri
+    client_secret_name          = azurerm_key_vault_secret.test.name
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
 func (DataFactoryResource) publicNetworkDisabled(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
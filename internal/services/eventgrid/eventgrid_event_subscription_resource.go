@@ -305,6 +305,11 @@ func resourceEventGridEventSubscriptionRead(d *pluginsdk.ResourceData, meta inte
 			if err := d.Set("service_bus_queue_endpoint_id", serviceBusQueueEndpoint.ResourceID); err != nil {
 				return fmt.Errorf("setting `service_bus_queue_endpoint_id` for %s: %+v", *id, err)
 			}
+			if serviceBusQueueEndpoint.DeliveryAttributeMappings != nil {
+				if err := d.Set("delivery_property", flattenDeliveryProperties(d, serviceBusQueueEndpoint.DeliveryAttributeMappings)); err != nil {
+					return fmt.Errorf("setting `delivery_property` for %s: %+v", *id, err)
+				}
+			}
 		}
 		if serviceBusTopicEndpoint, ok := destination.AsServiceBusTopicEventSubscriptionDestination(); ok {
 			if err := d.Set("service_bus_topic_endpoint_id", serviceBusTopicEndpoint.ResourceID); err != nil {
@@ -11,7 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubsclusters"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2022-01-01-preview/eventhubsclusters"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -55,16 +55,21 @@ func resourceEventHubCluster() *pluginsdk.Resource {
 
 			"location": commonschema.Location(),
 
+			// the capacity can only be changed in-place on self-serve scalable clusters, see `supports_scaling`
 			"sku_name": {
 				Type:     pluginsdk.TypeString,
 				Required: true,
-				ForceNew: true,
 				ValidateFunc: validation.StringMatch(
 					regexp.MustCompile(`^Dedicated_[1-9][0-9]*$`),
 					"SKU name must match /^Dedicated_[1-9][0-9]*$/.",
 				),
 			},
 
+			"supports_scaling": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
 			"tags": commonschema.Tags(),
 		},
 	}
@@ -91,6 +96,20 @@ func resourceEventHubClusterCreateUpdate(d *pluginsdk.ResourceData, meta interfa
 		}
 	}
 
+	if !d.IsNewResource() && d.HasChange("sku_name") {
+		existing, err := client.ClustersGet(ctx, id)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+		supportsScaling := false
+		if model := existing.Model; model != nil && model.Properties != nil && model.Properties.SupportsScaling != nil {
+			supportsScaling = *model.Properties.SupportsScaling
+		}
+		if !supportsScaling {
+			return fmt.Errorf("the capacity of %s can't be changed since it doesn't support scaling - scaling has to be requested through Azure Support", id)
+		}
+	}
+
 	cluster := eventhubsclusters.Cluster{
 		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
 		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
@@ -98,7 +117,7 @@ func resourceEventHubClusterCreateUpdate(d *pluginsdk.ResourceData, meta interfa
 	}
 
 	if err := client.ClustersCreateOrUpdateThenPoll(ctx, id, cluster); err != nil {
-		return fmt.Errorf("creating %s: %+v", id, err)
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
 	if d.IsNewResource() {
@@ -133,6 +152,12 @@ func resourceEventHubClusterRead(d *pluginsdk.ResourceData, meta interface{}) er
 		d.Set("sku_name", flattenEventHubClusterSkuName(model.Sku))
 		d.Set("location", location.NormalizeNilable(model.Location))
 
+		supportsScaling := false
+		if props := model.Properties; props != nil && props.SupportsScaling != nil {
+			supportsScaling = *props.SupportsScaling
+		}
+		d.Set("supports_scaling", supportsScaling)
+
 		return tags.FlattenAndSet(d, model.Tags)
 	}
 
@@ -1,8 +1,10 @@
 package firewall
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
@@ -18,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
@@ -48,6 +51,20 @@ func resourceFirewallPolicy() *pluginsdk.Resource {
 		},
 
 		Schema: resourceFirewallPolicySchema(),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			// TLS Inspection is only available for Premium policies and requires an identity to retrieve the certificate from the Key Vault
+			if len(d.Get("tls_certificate").([]interface{})) == 0 {
+				return nil
+			}
+			if sku := d.Get("sku").(string); sku != "" && sku != string(network.FirewallPolicySkuTierPremium) {
+				return fmt.Errorf("`tls_certificate` can only be specified when `sku` is `%s`", network.FirewallPolicySkuTierPremium)
+			}
+			if len(d.Get("identity").([]interface{})) == 0 {
+				return fmt.Errorf("`identity` must be specified when `tls_certificate` is specified")
+			}
+			return nil
+		}),
 	}
 }
 
@@ -76,12 +93,16 @@ func resourceFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 	if err != nil {
 		return fmt.Errorf("expanding `identity`: %+v", err)
 	}
+	intrusionDetection, err := expandFirewallPolicyIntrusionDetection(d.Get("intrusion_detection").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `intrusion_detection`: %+v", err)
+	}
 	props := network.FirewallPolicy{
 		FirewallPolicyPropertiesFormat: &network.FirewallPolicyPropertiesFormat{
 			ThreatIntelMode:      network.AzureFirewallThreatIntelMode(d.Get("threat_intelligence_mode").(string)),
 			ThreatIntelWhitelist: expandFirewallPolicyThreatIntelWhitelist(d.Get("threat_intelligence_allowlist").([]interface{})),
 			DNSSettings:          expandFirewallPolicyDNSSetting(d.Get("dns").([]interface{})),
-			IntrusionDetection:   expandFirewallPolicyIntrusionDetection(d.Get("intrusion_detection").([]interface{})),
+			IntrusionDetection:   intrusionDetection,
 			TransportSecurity:    expandFirewallPolicyTransportSecurity(d.Get("tls_certificate").([]interface{})),
 			Insights:             expandFirewallPolicyInsights(d.Get("insights").([]interface{})),
 		},
@@ -278,16 +299,24 @@ func expandFirewallPolicyDNSSetting(input []interface{}) *network.DNSSettings {
 	return output
 }
 
-func expandFirewallPolicyIntrusionDetection(input []interface{}) *network.FirewallPolicyIntrusionDetection {
+func expandFirewallPolicyIntrusionDetection(input []interface{}) (*network.FirewallPolicyIntrusionDetection, error) {
 	if len(input) == 0 || input[0] == nil {
-		return nil
+		return nil, nil
 	}
 
 	raw := input[0].(map[string]interface{})
 
+	// large rulesets are commonly generated (e.g. from a CSV file) so we check for duplicates here, since the API error doesn't include the signature
+	signatureIds := make(map[string]struct{})
 	var signatureOverrides []network.FirewallPolicyIntrusionDetectionSignatureSpecification
 	for _, v := range raw["signature_overrides"].([]interface{}) {
 		overrides := v.(map[string]interface{})
+		signatureId := overrides["id"].(string)
+		if _, exists := signatureIds[signatureId]; exists {
+			return nil, fmt.Errorf("the signature %q is overridden more than once - each signature can only be specified in a single `signature_overrides` block", signatureId)
+		}
+		signatureIds[signatureId] = struct{}{}
+
 		signatureOverrides = append(signatureOverrides, network.FirewallPolicyIntrusionDetectionSignatureSpecification{
 			ID:   utils.String(overrides["id"].(string)),
 			Mode: network.FirewallPolicyIntrusionDetectionStateType(overrides["state"].(string)),
@@ -322,7 +351,7 @@ func expandFirewallPolicyIntrusionDetection(input []interface{}) *network.Firewa
 			PrivateRanges:         &privateRanges,
 			BypassTrafficSettings: &trafficBypass,
 		},
-	}
+	}, nil
 }
 
 func expandFirewallPolicyTransportSecurity(input []interface{}) *network.FirewallPolicyTransportSecurity {
@@ -745,8 +774,9 @@ func resourceFirewallPolicySchema() map[string]*pluginsdk.Schema {
 									Optional: true,
 								},
 								"id": {
-									Type:     pluginsdk.TypeString,
-									Optional: true,
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d+$`), "the signature `id` must be numeric"),
 								},
 							},
 						},
@@ -755,7 +785,8 @@ func resourceFirewallPolicySchema() map[string]*pluginsdk.Schema {
 						Type:     pluginsdk.TypeList,
 						Optional: true,
 						Elem: &pluginsdk.Schema{
-							Type: pluginsdk.TypeString,
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.Any(validation.IsCIDR, validation.IsIPAddress),
 						},
 					},
 					"traffic_bypass": {
@@ -835,12 +866,14 @@ func resourceFirewallPolicySchema() map[string]*pluginsdk.Schema {
 			Elem: &pluginsdk.Resource{
 				Schema: map[string]*pluginsdk.Schema{
 					"key_vault_secret_id": {
-						Type:     pluginsdk.TypeString,
-						Required: true,
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
 					},
 					"name": {
-						Type:     pluginsdk.TypeString,
-						Required: true,
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
 					},
 				},
 			},
@@ -281,7 +281,11 @@ resource "azurerm_firewall_policy" "test" {
       state = "Alert"
       id    = "1"
     }
-    private_ranges = ["172.111.111.111"]
+    signature_overrides {
+      state = "Deny"
+      id    = "2024897"
+    }
+    private_ranges = ["172.111.111.111", "10.0.0.0/8"]
     traffic_bypass {
       name                  = "Name bypass traffic settings"
       description           = "Description bypass traffic settings"
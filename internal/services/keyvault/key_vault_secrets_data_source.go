@@ -6,10 +6,13 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -29,6 +32,20 @@ func dataSourceKeyVaultSecrets() *pluginsdk.Resource {
 				ValidateFunc: keyVaultValidate.VaultID,
 			},
 
+			"name_prefix": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"tags": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
 			"names": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -36,6 +53,52 @@ func dataSourceKeyVaultSecrets() *pluginsdk.Resource {
 					Type: pluginsdk.TypeString,
 				},
 			},
+
+			"secrets": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"content_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"not_before_date": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"expiration_date": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"tags": {
+							Type:     pluginsdk.TypeMap,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -63,26 +126,34 @@ func dataSourceKeyVaultSecretsRead(d *pluginsdk.ResourceData, meta interface{})
 
 	d.SetId(keyVaultId.ID())
 
-	var names []string
+	namePrefix := d.Get("name_prefix").(string)
+	filterTags := d.Get("tags").(map[string]interface{})
 
-	if secretList.Response().Value != nil {
-		for secretList.NotDone() {
-			for _, v := range *secretList.Response().Value {
-				name, err := parseNameFromSecretUrl(*v.ID)
-				if err != nil {
-					return err
-				}
+	names := make([]string, 0)
+	secrets := make([]interface{}, 0)
+	for secretList.NotDone() {
+		if v := secretList.Value(); v.ID != nil {
+			name, err := parseNameFromSecretUrl(*v.ID)
+			if err != nil {
+				return err
+			}
+
+			if strings.HasPrefix(*name, namePrefix) && keyVaultSecretItemHasTags(v, filterTags) {
 				names = append(names, *name)
-				err = secretList.NextWithContext(ctx)
-				if err != nil {
-					return fmt.Errorf("listing secrets on Azure KeyVault %q: %+v", *keyVaultId, err)
-				}
+				secrets = append(secrets, flattenKeyVaultSecretItem(*name, v))
 			}
 		}
+
+		if err := secretList.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing secrets on Azure KeyVault %q: %+v", *keyVaultId, err)
+		}
 	}
 
 	d.Set("names", names)
 	d.Set("key_vault_id", keyVaultId.ID())
+	if err := d.Set("secrets", secrets); err != nil {
+		return fmt.Errorf("setting `secrets`: %+v", err)
+	}
 
 	return nil
 }
@@ -99,3 +170,52 @@ func parseNameFromSecretUrl(input string) (*string, error) {
 	}
 	return &segments[2], nil
 }
+
+// keyVaultSecretItemHasTags returns whether the Secret has all of the specified tags with matching values
+func keyVaultSecretItemHasTags(input keyvault.SecretItem, filter map[string]interface{}) bool {
+	for key, value := range filter {
+		v, ok := input.Tags[key]
+		if !ok || v == nil || *v != value.(string) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func flattenKeyVaultSecretItem(name string, input keyvault.SecretItem) map[string]interface{} {
+	id := ""
+	if input.ID != nil {
+		id = *input.ID
+	}
+
+	contentType := ""
+	if input.ContentType != nil {
+		contentType = *input.ContentType
+	}
+
+	enabled := false
+	notBeforeDate := ""
+	expirationDate := ""
+	if attributes := input.Attributes; attributes != nil {
+		if attributes.Enabled != nil {
+			enabled = *attributes.Enabled
+		}
+		if v := attributes.NotBefore; v != nil {
+			notBeforeDate = time.Time(*v).Format(time.RFC3339)
+		}
+		if v := attributes.Expires; v != nil {
+			expirationDate = time.Time(*v).Format(time.RFC3339)
+		}
+	}
+
+	return map[string]interface{}{
+		"name":            name,
+		"id":              id,
+		"enabled":         enabled,
+		"content_type":    contentType,
+		"not_before_date": notBeforeDate,
+		"expiration_date": expirationDate,
+		"tags":            tags.Flatten(input.Tags),
+	}
+}
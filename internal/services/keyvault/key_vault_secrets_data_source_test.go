@@ -24,6 +24,24 @@ func TestAccDataSourceKeyVaultSecrets_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceKeyVaultSecrets_filtered(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_key_vault_secrets", "test")
+	r := KeyVaultSecretsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.filtered(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("names.#").HasValue("5"),
+				check.That(data.ResourceName).Key("secrets.#").HasValue("5"),
+				check.That(data.ResourceName).Key("secrets.0.enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("secrets.0.tags.environment").HasValue("Production"),
+				check.That(data.ResourceName).Key("secrets.0.id").Exists(),
+			),
+		},
+	})
+}
+
 func (KeyVaultSecretsDataSource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -42,3 +60,31 @@ data "azurerm_key_vault_secrets" "test" {
 }
 `, KeyVaultSecretResource{}.basic(data))
 }
+
+func (KeyVaultSecretsDataSource) filtered(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_secret" "test2" {
+  count        = 10
+  name         = "app-secret-${count.index}"
+  value        = "rick-and-morty"
+  key_vault_id = azurerm_key_vault.test.id
+
+  tags = {
+    environment = count.index %% 2 == 0 ? "Production" : "Test"
+  }
+}
+
+data "azurerm_key_vault_secrets" "test" {
+  key_vault_id = azurerm_key_vault.test.id
+  name_prefix  = "app-"
+
+  tags = {
+    environment = "Production"
+  }
+
+  depends_on = [azurerm_key_vault_secret.test, azurerm_key_vault_secret.test2]
+}
+`, KeyVaultSecretResource{}.basic(data))
+}
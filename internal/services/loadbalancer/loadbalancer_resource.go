@@ -1,6 +1,7 @@
 package loadbalancer
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -45,6 +46,26 @@ func resourceArmLoadBalancer() *pluginsdk.Resource {
 		},
 
 		Schema: resourceArmLoadBalancerSchema(),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			if !strings.EqualFold(d.Get("sku_tier").(string), string(network.LoadBalancerSkuTierGlobal)) {
+				return nil
+			}
+
+			if !strings.EqualFold(d.Get("sku").(string), string(network.LoadBalancerSkuNameStandard)) {
+				return fmt.Errorf("global load balancing is only supported for standard SKU load balancers")
+			}
+
+			// Global (cross-region) load balancers only support public frontends
+			for _, configRaw := range d.Get("frontend_ip_configuration").([]interface{}) {
+				config := configRaw.(map[string]interface{})
+				if config["subnet_id"].(string) != "" {
+					return fmt.Errorf("frontend IP configuration %q: `subnet_id` cannot be specified for a Global load balancer since only public frontends are supported", config["name"].(string))
+				}
+			}
+
+			return nil
+		}),
 	}
 }
 
@@ -71,12 +92,6 @@ func resourceArmLoadBalancerCreateUpdate(d *pluginsdk.ResourceData, meta interfa
 		}
 	}
 
-	if strings.EqualFold(d.Get("sku_tier").(string), string(network.LoadBalancerSkuTierGlobal)) {
-		if !strings.EqualFold(d.Get("sku").(string), string(network.LoadBalancerSkuNameStandard)) {
-			return fmt.Errorf("global load balancing is only supported for standard SKU load balancers")
-		}
-	}
-
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	sku := network.LoadBalancerSku{
 		Name: network.LoadBalancerSkuName(d.Get("sku").(string)),
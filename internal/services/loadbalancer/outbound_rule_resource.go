@@ -1,6 +1,7 @@
 package loadbalancer
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -111,6 +112,29 @@ func resourceArmLoadBalancerOutboundRule() *pluginsdk.Resource {
 				Default:  4,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+			// the Load Balancer may not exist yet, in which case this is checked again during apply
+			if d.Get("loadbalancer_id").(string) == "" {
+				return nil
+			}
+
+			loadBalancerId, err := parse.LoadBalancerID(d.Get("loadbalancer_id").(string))
+			if err != nil {
+				return err
+			}
+
+			client := meta.(*clients.Client).LoadBalancers.LoadBalancersClient
+			loadBalancer, err := client.Get(ctx, loadBalancerId.ResourceGroup, loadBalancerId.Name, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(loadBalancer.Response) {
+					return nil
+				}
+				return fmt.Errorf("retrieving %s: %+v", *loadBalancerId, err)
+			}
+
+			return validateLoadBalancerOutboundRuleSku(loadBalancer)
+		}),
 	}
 }
 
@@ -139,6 +163,10 @@ func resourceArmLoadBalancerOutboundRuleCreateUpdate(d *pluginsdk.ResourceData,
 		return fmt.Errorf("failed to retrieve Load Balancer %q (resource group %q) for Outbound Rule %q: %+v", id.LoadBalancerName, id.ResourceGroup, id.OutboundRuleName, err)
 	}
 
+	if err := validateLoadBalancerOutboundRuleSku(loadBalancer); err != nil {
+		return err
+	}
+
 	newOutboundRule, err := expandAzureRmLoadBalancerOutboundRule(d, &loadBalancer)
 	if err != nil {
 		return fmt.Errorf("expanding Load Balancer Outbound Rule: %+v", err)
@@ -347,3 +375,11 @@ func expandAzureRmLoadBalancerOutboundRule(d *pluginsdk.ResourceData, lb *networ
 		OutboundRulePropertiesFormat: &properties,
 	}, nil
 }
+
+func validateLoadBalancerOutboundRuleSku(loadBalancer network.LoadBalancer) error {
+	if loadBalancer.Sku != nil && loadBalancer.Sku.Tier == network.LoadBalancerSkuTierGlobal {
+		return fmt.Errorf("Outbound Rules are not supported for Global load balancers - use an Outbound Rule on the regional Load Balancer or a NAT Gateway instead")
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package logic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/parse"
+)
+
+// logicAppStandardWorkflowFile is the on-disk representation of a Logic App Standard Workflow, stored as
+// `{workflowName}/workflow.json` under the `wwwroot` of the Site
+type logicAppStandardWorkflowFile struct {
+	Definition map[string]interface{} `json:"definition"`
+	Kind       string                 `json:"kind"`
+}
+
+// logicAppStandardVfsClient talks to the Kudu VFS API of a Logic App Standard, since Workflows can't be
+// managed through the ARM API
+type logicAppStandardVfsClient struct {
+	host      string
+	username  string
+	password  string
+	userAgent string
+}
+
+func newLogicAppStandardVfsClient(ctx context.Context, client *web.AppsClient, id parse.LogicAppStandardId) (*logicAppStandardVfsClient, error) {
+	site, err := client.Get(ctx, id.ResourceGroup, id.SiteName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return newLogicAppStandardVfsClientForSite(ctx, client, id, site)
+}
+
+func newLogicAppStandardVfsClientForSite(ctx context.Context, client *web.AppsClient, id parse.LogicAppStandardId, site web.Site) (*logicAppStandardVfsClient, error) {
+	scmHost := ""
+	if props := site.SiteProperties; props != nil && props.HostNameSslStates != nil {
+		for _, v := range *props.HostNameSslStates {
+			if v.Name != nil && *v.Name != "" && v.HostType == web.HostTypeRepository {
+				scmHost = *v.Name
+				break
+			}
+		}
+	}
+	if scmHost == "" {
+		return nil, fmt.Errorf("could not determine the SCM Host for %s", id)
+	}
+
+	username, password, err := helpers.GetSitePublishingCredentials(ctx, client, id.ResourceGroup, id.SiteName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &logicAppStandardVfsClient{
+		host:      fmt.Sprintf("https://%s", scmHost),
+		username:  *username,
+		password:  *password,
+		userAgent: client.UserAgent,
+	}, nil
+}
+
+func (c logicAppStandardVfsClient) workflowFileUri(workflowName string) string {
+	return fmt.Sprintf("%s/api/vfs/site/wwwroot/%s/workflow.json", c.host, workflowName)
+}
+
+func (c logicAppStandardVfsClient) workflowDirectoryUri(workflowName string) string {
+	return fmt.Sprintf("%s/api/vfs/site/wwwroot/%s/?recursive=true", c.host, workflowName)
+}
+
+func (c logicAppStandardVfsClient) do(ctx context.Context, method string, uri string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, uri, body)
+	if err != nil {
+		return nil, fmt.Errorf("preparing request: %+v", err)
+	}
+
+	req.SetBasicAuth(c.username, c.password)
+	req.Header["Cache-Control"] = []string{"no-cache"}
+	req.Header["User-Agent"] = []string{c.userAgent}
+	if method != http.MethodGet {
+		// overwrite/remove the file regardless of its current ETag
+		req.Header["If-Match"] = []string{"*"}
+	}
+	if method == http.MethodPut {
+		req.Header["Content-Type"] = []string{"application/json"}
+	}
+
+	return http.DefaultClient.Do(req)
+}
+
+// GetWorkflow returns the Workflow file with the specified name, or nil if it doesn't exist
+func (c logicAppStandardVfsClient) GetWorkflow(ctx context.Context, workflowName string) (*logicAppStandardWorkflowFile, error) {
+	resp, err := c.do(ctx, http.MethodGet, c.workflowFileUri(workflowName), http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("sending request: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %+v", err)
+	}
+
+	var workflow logicAppStandardWorkflowFile
+	if err := json.Unmarshal(respBody, &workflow); err != nil {
+		return nil, fmt.Errorf("unmarshalling workflow file: %+v", err)
+	}
+
+	return &workflow, nil
+}
+
+func (c logicAppStandardVfsClient) PutWorkflow(ctx context.Context, workflowName string, workflow logicAppStandardWorkflowFile) error {
+	body, err := json.Marshal(workflow)
+	if err != nil {
+		return fmt.Errorf("marshalling workflow file: %+v", err)
+	}
+
+	resp, err := c.do(ctx, http.MethodPut, c.workflowFileUri(workflowName), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("sending request: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	return nil
+}
+
+func (c logicAppStandardVfsClient) DeleteWorkflow(ctx context.Context, workflowName string) error {
+	resp, err := c.do(ctx, http.MethodDelete, c.workflowDirectoryUri(workflowName), http.NoBody)
+	if err != nil {
+		return fmt.Errorf("sending request: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	return nil
+}
+
+// LogicAppStandardWorkflowExists checks for the presence of a Logic App Standard Workflow, and is used in the acceptance tests
+func LogicAppStandardWorkflowExists(ctx context.Context, client *web.AppsClient, id parse.LogicAppStandardWorkflowId) (bool, error) {
+	vfsClient, err := newLogicAppStandardVfsClient(ctx, client, parse.NewLogicAppStandardID(id.SubscriptionId, id.ResourceGroup, id.SiteName))
+	if err != nil {
+		return false, err
+	}
+
+	workflow, err := vfsClient.GetWorkflow(ctx, id.WorkflowName)
+	if err != nil {
+		return false, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return workflow != nil, nil
+}
@@ -0,0 +1,225 @@
+package logic
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceLogicAppStandardWorkflow() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceLogicAppStandardWorkflowCreate,
+		Read:   resourceLogicAppStandardWorkflowRead,
+		Update: resourceLogicAppStandardWorkflowUpdate,
+		Delete: resourceLogicAppStandardWorkflowDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.LogicAppStandardWorkflowID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.LogicAppStandardWorkflowName,
+			},
+
+			"logic_app_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.LogicAppStandardID,
+			},
+
+			"definition": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+			},
+
+			"kind": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "Stateful",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Stateful",
+					"Stateless",
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceLogicAppStandardWorkflowCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	logicAppId, err := parse.LogicAppStandardID(d.Get("logic_app_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewLogicAppStandardWorkflowID(logicAppId.SubscriptionId, logicAppId.ResourceGroup, logicAppId.SiteName, d.Get("name").(string))
+
+	vfsClient, err := newLogicAppStandardVfsClient(ctx, client, *logicAppId)
+	if err != nil {
+		return err
+	}
+
+	existing, err := vfsClient.GetWorkflow(ctx, id.WorkflowName)
+	if err != nil {
+		return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+	}
+	if existing != nil {
+		return tf.ImportAsExistsError("azurerm_logic_app_standard_workflow", id.ID())
+	}
+
+	workflow, err := expandLogicAppStandardWorkflowFile(d)
+	if err != nil {
+		return err
+	}
+
+	if err := vfsClient.PutWorkflow(ctx, id.WorkflowName, *workflow); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceLogicAppStandardWorkflowRead(d, meta)
+}
+
+func resourceLogicAppStandardWorkflowUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LogicAppStandardWorkflowID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	vfsClient, err := newLogicAppStandardVfsClient(ctx, client, parse.NewLogicAppStandardID(id.SubscriptionId, id.ResourceGroup, id.SiteName))
+	if err != nil {
+		return err
+	}
+
+	workflow, err := expandLogicAppStandardWorkflowFile(d)
+	if err != nil {
+		return err
+	}
+
+	if err := vfsClient.PutWorkflow(ctx, id.WorkflowName, *workflow); err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	return resourceLogicAppStandardWorkflowRead(d, meta)
+}
+
+func resourceLogicAppStandardWorkflowRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LogicAppStandardWorkflowID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	logicAppId := parse.NewLogicAppStandardID(id.SubscriptionId, id.ResourceGroup, id.SiteName)
+	site, err := client.Get(ctx, id.ResourceGroup, id.SiteName)
+	if err != nil {
+		if utils.ResponseWasNotFound(site.Response) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state", logicAppId, id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", logicAppId, err)
+	}
+
+	vfsClient, err := newLogicAppStandardVfsClientForSite(ctx, client, logicAppId, site)
+	if err != nil {
+		return err
+	}
+
+	workflow, err := vfsClient.GetWorkflow(ctx, id.WorkflowName)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if workflow == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.WorkflowName)
+	d.Set("logic_app_id", logicAppId.ID())
+
+	// the `kind` may be omitted from the Workflow file, in which case the Default is used
+	if workflow.Kind != "" {
+		d.Set("kind", workflow.Kind)
+	}
+
+	definition, err := json.Marshal(workflow.Definition)
+	if err != nil {
+		return fmt.Errorf("serializing `definition` for %s: %+v", id, err)
+	}
+	d.Set("definition", string(definition))
+
+	return nil
+}
+
+func resourceLogicAppStandardWorkflowDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LogicAppStandardWorkflowID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	vfsClient, err := newLogicAppStandardVfsClient(ctx, client, parse.NewLogicAppStandardID(id.SubscriptionId, id.ResourceGroup, id.SiteName))
+	if err != nil {
+		return err
+	}
+
+	if err := vfsClient.DeleteWorkflow(ctx, id.WorkflowName); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func expandLogicAppStandardWorkflowFile(d *pluginsdk.ResourceData) (*logicAppStandardWorkflowFile, error) {
+	var definition map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("definition").(string)), &definition); err != nil {
+		return nil, fmt.Errorf("unmarshalling JSON for `definition`: %+v", err)
+	}
+
+	return &logicAppStandardWorkflowFile{
+		Definition: definition,
+		Kind:       d.Get("kind").(string),
+	}, nil
+}
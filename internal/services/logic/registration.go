@@ -54,5 +54,6 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_logic_app_workflow":                                resourceLogicAppWorkflow(),
 		"azurerm_integration_service_environment":                   resourceIntegrationServiceEnvironment(),
 		"azurerm_logic_app_standard":                                resourceLogicAppStandard(),
+		"azurerm_logic_app_standard_workflow":                       resourceLogicAppStandardWorkflow(),
 	}
 }
@@ -0,0 +1,26 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func LogicAppStandardWorkflowName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if len(v) > 43 {
+		errors = append(errors, fmt.Errorf("length should be equal to or less than %d, got %q", 43, v))
+		return
+	}
+
+	if !regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]*$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must start with a letter and can contain only letters, numbers, underscores and hyphens", k))
+		return
+	}
+
+	return
+}
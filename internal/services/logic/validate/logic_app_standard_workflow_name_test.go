@@ -0,0 +1,57 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLogicAppStandardWorkflowName(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{
+			input: "",
+			valid: false,
+		},
+		{
+			input: "workflow1",
+			valid: true,
+		},
+		{
+			input: "my_workflow-1",
+			valid: true,
+		},
+		{
+			input: "1workflow",
+			valid: false,
+		},
+		{
+			input: "work.flow",
+			valid: false,
+		},
+		{
+			input: strings.Repeat("s", 42),
+			valid: true,
+		},
+		{
+			input: strings.Repeat("s", 43),
+			valid: true,
+		},
+		{
+			input: strings.Repeat("s", 44),
+			valid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LogicAppStandardWorkflowName(tt.input, "name")
+			valid := err == nil
+			if valid != tt.valid {
+				t.Errorf("Expected valid status %t but got %t for input %s", tt.valid, valid, tt.input)
+			}
+		})
+	}
+}
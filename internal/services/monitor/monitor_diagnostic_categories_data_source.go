@@ -84,7 +84,7 @@ func dataSourceMonitorDiagnosticCategoriesRead(d *pluginsdk.ResourceData, meta i
 		return fmt.Errorf("retrieving Diagnostics Categories for Resource %q: %+v", actualResourceId, err)
 	}
 
-	if categories.Model == nil && categories.Model.Value == nil {
+	if categories.Model == nil || categories.Model.Value == nil {
 		return fmt.Errorf("retrieving Diagnostics Categories for Resource %q: `categories.Value` was nil", actualResourceId)
 	}
 
@@ -208,7 +208,10 @@ func resourceMonitorDiagnosticSettingCreateUpdate(d *pluginsdk.ResourceData, met
 	}
 
 	logsRaw := d.Get("log").(*pluginsdk.Set).List()
-	logs := expandMonitorDiagnosticsSettingsLogs(logsRaw)
+	logs, err := expandMonitorDiagnosticsSettingsLogs(logsRaw)
+	if err != nil {
+		return err
+	}
 	metricsRaw := d.Get("metric").(*pluginsdk.Set).List()
 	metrics := expandMonitorDiagnosticsSettingsMetrics(metricsRaw)
 
@@ -420,7 +423,7 @@ func monitorDiagnosticSettingDeletedRefreshFunc(ctx context.Context, client *dia
 	}
 }
 
-func expandMonitorDiagnosticsSettingsLogs(input []interface{}) []diagnosticsettings.LogSettings {
+func expandMonitorDiagnosticsSettingsLogs(input []interface{}) ([]diagnosticsettings.LogSettings, error) {
 	results := make([]diagnosticsettings.LogSettings, 0)
 
 	for _, raw := range input {
@@ -428,6 +431,10 @@ func expandMonitorDiagnosticsSettingsLogs(input []interface{}) []diagnosticsetti
 
 		category := v["category"].(string)
 		categoryGroup := v["category_group"].(string)
+		if (category == "") == (categoryGroup == "") {
+			return nil, fmt.Errorf("exactly one of `category` or `category_group` must be specified within a `log` block")
+		}
+
 		enabled := v["enabled"].(bool)
 		policiesRaw := v["retention_policy"].([]interface{})
 		var retentionPolicy *diagnosticsettings.RetentionPolicy
@@ -454,7 +461,7 @@ func expandMonitorDiagnosticsSettingsLogs(input []interface{}) []diagnosticsetti
 		results = append(results, output)
 	}
 
-	return results
+	return results, nil
 }
 
 func flattenMonitorDiagnosticLogs(input *[]diagnosticsettings.LogSettings) []interface{} {
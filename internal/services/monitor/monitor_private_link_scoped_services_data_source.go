@@ -0,0 +1,101 @@
+package monitor
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceMonitorPrivateLinkScopedServices() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceMonitorPrivateLinkScopedServicesRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"scope_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.PrivateLinkScopeName,
+			},
+
+			"resource_group_name": commonschema.ResourceGroupNameForDataSource(),
+
+			"scoped_service": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"linked_resource_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMonitorPrivateLinkScopedServicesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.PrivateLinkScopedResourcesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewPrivateLinkScopeID(subscriptionId, d.Get("resource_group_name").(string), d.Get("scope_name").(string))
+
+	iter, err := client.ListByPrivateLinkScopeComplete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("listing Scoped Services for %s: %+v", id, err)
+	}
+
+	scopedServices := make([]interface{}, 0)
+	for iter.NotDone() {
+		item := iter.Value()
+		if item.Name != nil {
+			serviceId := parse.NewPrivateLinkScopedServiceID(id.SubscriptionId, id.ResourceGroup, id.Name, *item.Name)
+
+			linkedResourceId := ""
+			if props := item.ScopedResourceProperties; props != nil && props.LinkedResourceID != nil {
+				linkedResourceId = *props.LinkedResourceID
+			}
+
+			scopedServices = append(scopedServices, map[string]interface{}{
+				"id":                 serviceId.ID(),
+				"name":               serviceId.ScopedResourceName,
+				"linked_resource_id": linkedResourceId,
+			})
+		}
+
+		if err := iter.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Scoped Services for %s: %+v", id, err)
+		}
+	}
+
+	d.SetId(id.ID())
+
+	if err := d.Set("scoped_service", scopedServices); err != nil {
+		return fmt.Errorf("setting `scoped_service`: %+v", err)
+	}
+
+	return nil
+}
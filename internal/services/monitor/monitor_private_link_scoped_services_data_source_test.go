@@ -0,0 +1,39 @@
+package monitor_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type MonitorPrivateLinkScopedServicesDataSource struct{}
+
+func TestAccDataSourceMonitorPrivateLinkScopedServices_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_monitor_private_link_scoped_services", "test")
+	r := MonitorPrivateLinkScopedServicesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("scoped_service.#").HasValue("1"),
+				check.That(data.ResourceName).Key("scoped_service.0.id").Exists(),
+				check.That(data.ResourceName).Key("scoped_service.0.name").HasValue(fmt.Sprintf("acctest-plss-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("scoped_service.0.linked_resource_id").Exists(),
+			),
+		},
+	})
+}
+
+func (MonitorPrivateLinkScopedServicesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_monitor_private_link_scoped_services" "test" {
+  scope_name          = azurerm_monitor_private_link_scoped_service.test.scope_name
+  resource_group_name = azurerm_monitor_private_link_scoped_service.test.resource_group_name
+}
+`, MonitorPrivateLinkScopedServiceResource{}.basic(data))
+}
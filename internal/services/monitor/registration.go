@@ -47,11 +47,12 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_monitor_action_group":                dataSourceMonitorActionGroup(),
-		"azurerm_monitor_diagnostic_categories":       dataSourceMonitorDiagnosticCategories(),
-		"azurerm_monitor_log_profile":                 dataSourceMonitorLogProfile(),
-		"azurerm_monitor_scheduled_query_rules_alert": dataSourceMonitorScheduledQueryRulesAlert(),
-		"azurerm_monitor_scheduled_query_rules_log":   dataSourceMonitorScheduledQueryRulesLog(),
+		"azurerm_monitor_action_group":                 dataSourceMonitorActionGroup(),
+		"azurerm_monitor_diagnostic_categories":        dataSourceMonitorDiagnosticCategories(),
+		"azurerm_monitor_log_profile":                  dataSourceMonitorLogProfile(),
+		"azurerm_monitor_private_link_scoped_services": dataSourceMonitorPrivateLinkScopedServices(),
+		"azurerm_monitor_scheduled_query_rules_alert":  dataSourceMonitorScheduledQueryRulesAlert(),
+		"azurerm_monitor_scheduled_query_rules_log":    dataSourceMonitorScheduledQueryRulesLog(),
 	}
 }
 
@@ -0,0 +1,189 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceBastionHostShareableLink() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceBastionHostShareableLinkCreate,
+		Read:   resourceBastionHostShareableLinkRead,
+		Delete: resourceBastionHostShareableLinkDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.BastionHostShareableLinkID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"bastion_host_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.BastionHostID,
+			},
+
+			"virtual_machine_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: computeValidate.VirtualMachineID,
+			},
+
+			"url": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"created_at": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBastionHostShareableLinkCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ManagementClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	bastionHostId, err := parse.BastionHostID(d.Get("bastion_host_id").(string))
+	if err != nil {
+		return err
+	}
+	id := parse.NewBastionHostShareableLinkID(*bastionHostId, d.Get("virtual_machine_id").(string))
+
+	locks.ByID(id.BastionHost.ID())
+	defer locks.UnlockByID(id.BastionHost.ID())
+
+	existing, err := findBastionHostShareableLink(ctx, client, id)
+	if err != nil {
+		return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+	}
+	if existing != nil {
+		return tf.ImportAsExistsError("azurerm_bastion_host_shareable_link", id.ID())
+	}
+
+	future, err := client.PutBastionShareableLink(ctx, id.BastionHost.ResourceGroup, id.BastionHost.Name, expandBastionHostShareableLinkRequest(id))
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceBastionHostShareableLinkRead(d, meta)
+}
+
+func resourceBastionHostShareableLinkRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ManagementClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BastionHostShareableLinkID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	link, err := findBastionHostShareableLink(ctx, client, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if link == nil {
+		log.Printf("[INFO] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("bastion_host_id", id.BastionHost.ID())
+	d.Set("virtual_machine_id", id.VirtualMachineID)
+	d.Set("url", link.Bsl)
+	d.Set("created_at", link.CreatedAt)
+
+	return nil
+}
+
+func resourceBastionHostShareableLinkDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ManagementClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BastionHostShareableLinkID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByID(id.BastionHost.ID())
+	defer locks.UnlockByID(id.BastionHost.ID())
+
+	future, err := client.DeleteBastionShareableLink(ctx, id.BastionHost.ResourceGroup, id.BastionHost.Name, expandBastionHostShareableLinkRequest(*id))
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// findBastionHostShareableLink returns the Shareable Link for the Virtual Machine, or nil if one doesn't exist
+func findBastionHostShareableLink(ctx context.Context, client *network.BaseClient, id parse.BastionHostShareableLinkId) (*network.BastionShareableLink, error) {
+	iterator, err := client.GetBastionShareableLinkComplete(ctx, id.BastionHost.ResourceGroup, id.BastionHost.Name, expandBastionHostShareableLinkRequest(id))
+	if err != nil {
+		if utils.ResponseWasNotFound(iterator.Response().Response) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	for iterator.NotDone() {
+		link := iterator.Value()
+		if link.VM != nil && link.VM.ID != nil && strings.EqualFold(*link.VM.ID, id.VirtualMachineID) {
+			return &link, nil
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+func expandBastionHostShareableLinkRequest(id parse.BastionHostShareableLinkId) network.BastionShareableLinkListRequest {
+	return network.BastionShareableLinkListRequest{
+		Vms: &[]network.BastionShareableLink{
+			{
+				VM: &network.VM{
+					ID: utils.String(id.VirtualMachineID),
+				},
+			},
+		},
+	}
+}
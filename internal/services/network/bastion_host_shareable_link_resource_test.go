@@ -0,0 +1,155 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type BastionHostShareableLinkResource struct{}
+
+func TestAccBastionHostShareableLink_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_bastion_host_shareable_link", "test")
+	r := BastionHostShareableLinkResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("url").IsSet(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccBastionHostShareableLink_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_bastion_host_shareable_link", "test")
+	r := BastionHostShareableLinkResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (BastionHostShareableLinkResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.BastionHostShareableLinkID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	request := network.BastionShareableLinkListRequest{
+		Vms: &[]network.BastionShareableLink{
+			{
+				VM: &network.VM{
+					ID: utils.String(id.VirtualMachineID),
+				},
+			},
+		},
+	}
+	iterator, err := clients.Network.ManagementClient.GetBastionShareableLinkComplete(ctx, id.BastionHost.ResourceGroup, id.BastionHost.Name, request)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	for iterator.NotDone() {
+		link := iterator.Value()
+		if link.VM != nil && link.VM.ID != nil && strings.EqualFold(*link.VM.ID, id.VirtualMachineID) {
+			return utils.Bool(true), nil
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r BastionHostShareableLinkResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_bastion_host_shareable_link" "test" {
+  bastion_host_id    = azurerm_bastion_host.test.id
+  virtual_machine_id = azurerm_linux_virtual_machine.test.id
+}
+`, r.template(data))
+}
+
+func (r BastionHostShareableLinkResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_bastion_host_shareable_link" "import" {
+  bastion_host_id    = azurerm_bastion_host_shareable_link.test.bastion_host_id
+  virtual_machine_id = azurerm_bastion_host_shareable_link.test.virtual_machine_id
+}
+`, r.basic(data))
+}
+
+func (BastionHostShareableLinkResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet" "vm" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["192.168.1.0/27"]
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctestnic-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "internal"
+    subnet_id                     = azurerm_subnet.vm.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_linux_virtual_machine" "test" {
+  name                            = "acctestVM-%d"
+  resource_group_name             = azurerm_resource_group.test.name
+  location                        = azurerm_resource_group.test.location
+  size                            = "Standard_F2"
+  admin_username                  = "adminuser"
+  admin_password                  = "P@$$w0rd1234!"
+  disable_password_authentication = false
+  network_interface_ids = [
+    azurerm_network_interface.test.id,
+  ]
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+}
+`, BastionHostResource{}.standardSku(data), data.RandomInteger, data.RandomInteger)
+}
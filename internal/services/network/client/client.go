@@ -25,6 +25,7 @@ type Client struct {
 	InterfacesClient                       *network.InterfacesClient
 	IPGroupsClient                         *network.IPGroupsClient
 	LocalNetworkGatewaysClient             *network.LocalNetworkGatewaysClient
+	ManagementClient                       *network.BaseClient
 	NatRuleClient                          *network.NatRulesClient
 	PointToSiteVpnGatewaysClient           *network.P2sVpnGatewaysClient
 	ProfileClient                          *network.ProfilesClient
@@ -35,6 +36,7 @@ type Client struct {
 	RoutesClient                           *network.RoutesClient
 	RouteFiltersClient                     *network.RouteFiltersClient
 	RouteTablesClient                      *network.RouteTablesClient
+	RoutingIntentClient                    *network.RoutingIntentClient
 	SecurityGroupClient                    *network.SecurityGroupsClient
 	SecurityPartnerProviderClient          *network.SecurityPartnerProvidersClient
 	SecurityRuleClient                     *network.SecurityRulesClient
@@ -44,6 +46,7 @@ type Client struct {
 	SubnetsClient                          *network.SubnetsClient
 	NatGatewayClient                       *network.NatGatewaysClient
 	VirtualHubBgpConnectionClient          *network.VirtualHubBgpConnectionClient
+	VirtualHubBgpConnectionsClient         *network.VirtualHubBgpConnectionsClient
 	VirtualHubIPClient                     *network.VirtualHubIPConfigurationClient
 	VnetGatewayConnectionsClient           *network.VirtualNetworkGatewayConnectionsClient
 	VnetGatewayNatRuleClient               *network.VirtualNetworkGatewayNatRulesClient
@@ -122,6 +125,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	LocalNetworkGatewaysClient := network.NewLocalNetworkGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&LocalNetworkGatewaysClient.Client, o.ResourceManagerAuthorizer)
 
+	ManagementClient := network.NewWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&ManagementClient.Client, o.ResourceManagerAuthorizer)
+
 	NatRuleClient := network.NewNatRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&NatRuleClient.Client, o.ResourceManagerAuthorizer)
 
@@ -167,6 +173,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	RouteTablesClient := network.NewRouteTablesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&RouteTablesClient.Client, o.ResourceManagerAuthorizer)
 
+	RoutingIntentClient := network.NewRoutingIntentClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&RoutingIntentClient.Client, o.ResourceManagerAuthorizer)
+
 	SecurityGroupClient := network.NewSecurityGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&SecurityGroupClient.Client, o.ResourceManagerAuthorizer)
 
@@ -191,6 +200,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	VirtualHubBgpConnectionClient := network.NewVirtualHubBgpConnectionClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&VirtualHubBgpConnectionClient.Client, o.ResourceManagerAuthorizer)
 
+	VirtualHubBgpConnectionsClient := network.NewVirtualHubBgpConnectionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&VirtualHubBgpConnectionsClient.Client, o.ResourceManagerAuthorizer)
+
 	VirtualHubIPClient := network.NewVirtualHubIPConfigurationClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&VirtualHubIPClient.Client, o.ResourceManagerAuthorizer)
 
@@ -253,6 +265,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		InterfacesClient:                       &InterfacesClient,
 		IPGroupsClient:                         &IpGroupsClient,
 		LocalNetworkGatewaysClient:             &LocalNetworkGatewaysClient,
+		ManagementClient:                       &ManagementClient,
 		NatRuleClient:                          &NatRuleClient,
 		PointToSiteVpnGatewaysClient:           &pointToSiteVpnGatewaysClient,
 		ProfileClient:                          &ProfileClient,
@@ -263,6 +276,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		RoutesClient:                           &RoutesClient,
 		RouteFiltersClient:                     &RouteFiltersClient,
 		RouteTablesClient:                      &RouteTablesClient,
+		RoutingIntentClient:                    &RoutingIntentClient,
 		SecurityGroupClient:                    &SecurityGroupClient,
 		SecurityPartnerProviderClient:          &SecurityPartnerProviderClient,
 		SecurityRuleClient:                     &SecurityRuleClient,
@@ -272,6 +286,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		SubnetsClient:                          &SubnetsClient,
 		NatGatewayClient:                       &NatGatewayClient,
 		VirtualHubBgpConnectionClient:          &VirtualHubBgpConnectionClient,
+		VirtualHubBgpConnectionsClient:         &VirtualHubBgpConnectionsClient,
 		VirtualHubIPClient:                     &VirtualHubIPClient,
 		VnetGatewayConnectionsClient:           &VnetGatewayConnectionsClient,
 		VnetGatewayNatRuleClient:               &VnetGatewayNatRuleClient,
@@ -0,0 +1,51 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	computeParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+)
+
+type BastionHostShareableLinkId struct {
+	BastionHost      BastionHostId
+	VirtualMachineID string
+}
+
+func NewBastionHostShareableLinkID(bastionHost BastionHostId, virtualMachineId string) BastionHostShareableLinkId {
+	return BastionHostShareableLinkId{
+		BastionHost:      bastionHost,
+		VirtualMachineID: virtualMachineId,
+	}
+}
+
+func (id BastionHostShareableLinkId) String() string {
+	return fmt.Sprintf("Shareable Link for Virtual Machine %q on %s", id.VirtualMachineID, id.BastionHost)
+}
+
+func (id BastionHostShareableLinkId) ID() string {
+	return fmt.Sprintf("%s|%s", id.BastionHost.ID(), id.VirtualMachineID)
+}
+
+func BastionHostShareableLinkID(input string) (*BastionHostShareableLinkId, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 2 {
+		return nil, fmt.Errorf("Expected an ID in the format `{bastionHostID}|{virtualMachineID} but got %q", input)
+	}
+
+	bastionHostId, err := BastionHostID(segments[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing Bastion Host ID %q: %+v", segments[0], err)
+	}
+
+	// whilst we need the Resource ID, we may as well validate it
+	virtualMachineId := segments[1]
+	if _, err := computeParse.VirtualMachineID(virtualMachineId); err != nil {
+		return nil, fmt.Errorf("parsing Virtual Machine ID %q: %+v", virtualMachineId, err)
+	}
+
+	return &BastionHostShareableLinkId{
+		BastionHost:      *bastionHostId,
+		VirtualMachineID: virtualMachineId,
+	}, nil
+}
@@ -0,0 +1,87 @@
+package parse
+
+import (
+	"testing"
+)
+
+func TestBastionHostShareableLinkID(t *testing.T) {
+	testData := []struct {
+		Name   string
+		Input  string
+		Error  bool
+		Expect *BastionHostShareableLinkId
+	}{
+		{
+			Name:  "Empty",
+			Input: "",
+			Error: true,
+		},
+		{
+			Name:  "One Segment",
+			Input: "hello",
+			Error: true,
+		},
+		{
+			Name:  "Two Segments Invalid ID's",
+			Input: "hello|world",
+			Error: true,
+		},
+		{
+			Name:  "Bastion Host ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/bastionHosts/bastion1",
+			Error: true,
+		},
+		{
+			Name:  "Virtual Machine ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group2/providers/Microsoft.Compute/virtualMachines/machine1",
+			Error: true,
+		},
+		{
+			Name:  "Bastion Host / Public IP Address ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/bastionHosts/bastion1|/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group2/providers/Microsoft.Network/publicIPAddresses/ip1",
+			Error: true,
+		},
+		{
+			Name:  "Bastion Host Shareable Link ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/bastionHosts/bastion1|/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group2/providers/Microsoft.Compute/virtualMachines/machine1",
+			Error: false,
+			Expect: &BastionHostShareableLinkId{
+				BastionHost: BastionHostId{
+					SubscriptionId: "00000000-0000-0000-0000-000000000000",
+					ResourceGroup:  "group1",
+					Name:           "bastion1",
+				},
+				VirtualMachineID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group2/providers/Microsoft.Compute/virtualMachines/machine1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := BastionHostShareableLinkID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Error {
+			t.Fatal("Expected an error but didn't get one")
+		}
+
+		if actual.BastionHost != v.Expect.BastionHost {
+			t.Fatalf("Expected %+v but got %+v for Bastion Host", v.Expect.BastionHost, actual.BastionHost)
+		}
+
+		if actual.VirtualMachineID != v.Expect.VirtualMachineID {
+			t.Fatalf("Expected %q but got %q for Virtual Machine ID", v.Expect.VirtualMachineID, actual.VirtualMachineID)
+		}
+
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected %q but got %q for ID", v.Input, actual.ID())
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+)
+
+type PrivateEndpointApplicationSecurityGroupAssociationId struct {
+	PrivateEndpoint          PrivateEndpointId
+	ApplicationSecurityGroup ApplicationSecurityGroupId
+}
+
+func NewPrivateEndpointApplicationSecurityGroupAssociationID(privateEndpoint PrivateEndpointId, applicationSecurityGroup ApplicationSecurityGroupId) PrivateEndpointApplicationSecurityGroupAssociationId {
+	return PrivateEndpointApplicationSecurityGroupAssociationId{
+		PrivateEndpoint:          privateEndpoint,
+		ApplicationSecurityGroup: applicationSecurityGroup,
+	}
+}
+
+func (id PrivateEndpointApplicationSecurityGroupAssociationId) String() string {
+	return fmt.Sprintf("Association between %s and %s", id.PrivateEndpoint, id.ApplicationSecurityGroup)
+}
+
+func (id PrivateEndpointApplicationSecurityGroupAssociationId) ID() string {
+	return fmt.Sprintf("%s|%s", id.PrivateEndpoint.ID(), id.ApplicationSecurityGroup.ID())
+}
+
+func PrivateEndpointApplicationSecurityGroupAssociationID(input string) (*PrivateEndpointApplicationSecurityGroupAssociationId, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 2 {
+		return nil, fmt.Errorf("Expected an ID in the format `{privateEndpointID}|{applicationSecurityGroupID} but got %q", input)
+	}
+
+	privateEndpointId, err := PrivateEndpointID(segments[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing Private Endpoint ID %q: %+v", segments[0], err)
+	}
+
+	applicationSecurityGroupId, err := ApplicationSecurityGroupID(segments[1])
+	if err != nil {
+		return nil, fmt.Errorf("parsing Application Security Group ID %q: %+v", segments[1], err)
+	}
+
+	return &PrivateEndpointApplicationSecurityGroupAssociationId{
+		PrivateEndpoint:          *privateEndpointId,
+		ApplicationSecurityGroup: *applicationSecurityGroupId,
+	}, nil
+}
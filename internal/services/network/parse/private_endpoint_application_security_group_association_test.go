@@ -0,0 +1,86 @@
+package parse
+
+import (
+	"testing"
+)
+
+func TestPrivateEndpointApplicationSecurityGroupAssociationID(t *testing.T) {
+	testData := []struct {
+		Name   string
+		Input  string
+		Error  bool
+		Expect *PrivateEndpointApplicationSecurityGroupAssociationId
+	}{
+		{
+			Name:  "Empty",
+			Input: "",
+			Error: true,
+		},
+		{
+			Name:  "One Segment",
+			Input: "hello",
+			Error: true,
+		},
+		{
+			Name:  "Two Segments Invalid ID's",
+			Input: "hello|world",
+			Error: true,
+		},
+		{
+			Name:  "Private Endpoint ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/privateEndpoints/endpoint1",
+			Error: true,
+		},
+		{
+			Name:  "Application Security Group ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/applicationSecurityGroups/group1",
+			Error: true,
+		},
+		{
+			Name:  "Private Endpoint / Application Security Group Association ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/privateEndpoints/endpoint1|/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group2/providers/Microsoft.Network/applicationSecurityGroups/asg1",
+			Error: false,
+			Expect: &PrivateEndpointApplicationSecurityGroupAssociationId{
+				PrivateEndpoint: PrivateEndpointId{
+					SubscriptionId: "00000000-0000-0000-0000-000000000000",
+					ResourceGroup:  "group1",
+					Name:           "endpoint1",
+				},
+				ApplicationSecurityGroup: ApplicationSecurityGroupId{
+					SubscriptionId: "00000000-0000-0000-0000-000000000000",
+					ResourceGroup:  "group2",
+					Name:           "asg1",
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := PrivateEndpointApplicationSecurityGroupAssociationID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Error {
+			t.Fatal("Expected an error but didn't get one")
+		}
+
+		if actual.PrivateEndpoint != v.Expect.PrivateEndpoint {
+			t.Fatalf("Expected %+v but got %+v for Private Endpoint", v.Expect.PrivateEndpoint, actual.PrivateEndpoint)
+		}
+
+		if actual.ApplicationSecurityGroup != v.Expect.ApplicationSecurityGroup {
+			t.Fatalf("Expected %+v but got %+v for Application Security Group", v.Expect.ApplicationSecurityGroup, actual.ApplicationSecurityGroup)
+		}
+
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected %q but got %q for ID", v.Input, actual.ID())
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type RoutingIntentId struct {
+	SubscriptionId    string
+	ResourceGroup     string
+	VirtualHubName    string
+	RoutingIntentName string
+}
+
+func NewRoutingIntentID(subscriptionId, resourceGroup, virtualHubName, routingIntentName string) RoutingIntentId {
+	return RoutingIntentId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroup:     resourceGroup,
+		VirtualHubName:    virtualHubName,
+		RoutingIntentName: routingIntentName,
+	}
+}
+
+func (id RoutingIntentId) String() string {
+	segments := []string{
+		fmt.Sprintf("Routing Intent Name %q", id.RoutingIntentName),
+		fmt.Sprintf("Virtual Hub Name %q", id.VirtualHubName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Routing Intent", segmentsStr)
+}
+
+func (id RoutingIntentId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualHubs/%s/routingIntent/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualHubName, id.RoutingIntentName)
+}
+
+// RoutingIntentID parses a RoutingIntent ID into an RoutingIntentId struct
+func RoutingIntentID(input string) (*RoutingIntentId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := RoutingIntentId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.VirtualHubName, err = id.PopSegment("virtualHubs"); err != nil {
+		return nil, err
+	}
+	if resourceId.RoutingIntentName, err = id.PopSegment("routingIntent"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,205 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourcePrivateEndpointApplicationSecurityGroupAssociation() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourcePrivateEndpointApplicationSecurityGroupAssociationCreate,
+		Read:   resourcePrivateEndpointApplicationSecurityGroupAssociationRead,
+		Delete: resourcePrivateEndpointApplicationSecurityGroupAssociationDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.PrivateEndpointApplicationSecurityGroupAssociationID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"private_endpoint_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.PrivateEndpointID,
+			},
+
+			"application_security_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ApplicationSecurityGroupID,
+			},
+		},
+	}
+}
+
+func resourcePrivateEndpointApplicationSecurityGroupAssociationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.PrivateEndpointClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	privateEndpointId, err := parse.PrivateEndpointID(d.Get("private_endpoint_id").(string))
+	if err != nil {
+		return err
+	}
+	applicationSecurityGroupId, err := parse.ApplicationSecurityGroupID(d.Get("application_security_group_id").(string))
+	if err != nil {
+		return err
+	}
+	id := parse.NewPrivateEndpointApplicationSecurityGroupAssociationID(*privateEndpointId, *applicationSecurityGroupId)
+
+	locks.ByName(privateEndpointId.Name, "azurerm_private_endpoint")
+	defer locks.UnlockByName(privateEndpointId.Name, "azurerm_private_endpoint")
+
+	privateEndpoint, err := client.Get(ctx, privateEndpointId.ResourceGroup, privateEndpointId.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(privateEndpoint.Response) {
+			return fmt.Errorf("%s was not found", *privateEndpointId)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *privateEndpointId, err)
+	}
+	if privateEndpoint.PrivateEndpointProperties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *privateEndpointId)
+	}
+
+	applicationSecurityGroups := make([]network.ApplicationSecurityGroup, 0)
+	if existing := privateEndpoint.PrivateEndpointProperties.ApplicationSecurityGroups; existing != nil {
+		for _, group := range *existing {
+			if group.ID == nil {
+				continue
+			}
+
+			if strings.EqualFold(*group.ID, applicationSecurityGroupId.ID()) {
+				return tf.ImportAsExistsError("azurerm_private_endpoint_application_security_group_association", id.ID())
+			}
+
+			applicationSecurityGroups = append(applicationSecurityGroups, group)
+		}
+	}
+	applicationSecurityGroups = append(applicationSecurityGroups, network.ApplicationSecurityGroup{
+		ID: utils.String(applicationSecurityGroupId.ID()),
+	})
+	privateEndpoint.PrivateEndpointProperties.ApplicationSecurityGroups = &applicationSecurityGroups
+
+	if err := updatePrivateEndpointApplicationSecurityGroups(ctx, client, *privateEndpointId, privateEndpoint); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourcePrivateEndpointApplicationSecurityGroupAssociationRead(d, meta)
+}
+
+func resourcePrivateEndpointApplicationSecurityGroupAssociationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.PrivateEndpointClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.PrivateEndpointApplicationSecurityGroupAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	privateEndpoint, err := client.Get(ctx, id.PrivateEndpoint.ResourceGroup, id.PrivateEndpoint.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(privateEndpoint.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id.PrivateEndpoint)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id.PrivateEndpoint, err)
+	}
+
+	exists := false
+	if props := privateEndpoint.PrivateEndpointProperties; props != nil && props.ApplicationSecurityGroups != nil {
+		for _, group := range *props.ApplicationSecurityGroups {
+			if group.ID != nil && strings.EqualFold(*group.ID, id.ApplicationSecurityGroup.ID()) {
+				exists = true
+				break
+			}
+		}
+	}
+	if !exists {
+		log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("private_endpoint_id", id.PrivateEndpoint.ID())
+	d.Set("application_security_group_id", id.ApplicationSecurityGroup.ID())
+
+	return nil
+}
+
+func resourcePrivateEndpointApplicationSecurityGroupAssociationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.PrivateEndpointClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.PrivateEndpointApplicationSecurityGroupAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.PrivateEndpoint.Name, "azurerm_private_endpoint")
+	defer locks.UnlockByName(id.PrivateEndpoint.Name, "azurerm_private_endpoint")
+
+	privateEndpoint, err := client.Get(ctx, id.PrivateEndpoint.ResourceGroup, id.PrivateEndpoint.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(privateEndpoint.Response) {
+			return fmt.Errorf("%s was not found", id.PrivateEndpoint)
+		}
+		return fmt.Errorf("retrieving %s: %+v", id.PrivateEndpoint, err)
+	}
+	if privateEndpoint.PrivateEndpointProperties == nil || privateEndpoint.PrivateEndpointProperties.ApplicationSecurityGroups == nil {
+		return nil
+	}
+
+	applicationSecurityGroups := make([]network.ApplicationSecurityGroup, 0)
+	for _, group := range *privateEndpoint.PrivateEndpointProperties.ApplicationSecurityGroups {
+		if group.ID != nil && strings.EqualFold(*group.ID, id.ApplicationSecurityGroup.ID()) {
+			continue
+		}
+		applicationSecurityGroups = append(applicationSecurityGroups, group)
+	}
+	privateEndpoint.PrivateEndpointProperties.ApplicationSecurityGroups = &applicationSecurityGroups
+
+	if err := updatePrivateEndpointApplicationSecurityGroups(ctx, client, id.PrivateEndpoint, privateEndpoint); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func updatePrivateEndpointApplicationSecurityGroups(ctx context.Context, client *network.PrivateEndpointsClient, id parse.PrivateEndpointId, privateEndpoint network.PrivateEndpoint) error {
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, privateEndpoint)
+	if err != nil {
+		return err
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s: %+v", id, err)
+	}
+
+	return nil
+}
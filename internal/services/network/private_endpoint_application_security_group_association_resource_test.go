@@ -0,0 +1,171 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PrivateEndpointApplicationSecurityGroupAssociationResource struct{}
+
+func TestAccPrivateEndpointApplicationSecurityGroupAssociation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_endpoint_application_security_group_association", "test")
+	r := PrivateEndpointApplicationSecurityGroupAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPrivateEndpointApplicationSecurityGroupAssociation_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_endpoint_application_security_group_association", "test")
+	r := PrivateEndpointApplicationSecurityGroupAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccPrivateEndpointApplicationSecurityGroupAssociation_updatePrivateEndpoint(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_endpoint_application_security_group_association", "test")
+	r := PrivateEndpointApplicationSecurityGroupAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// updating the Private Endpoint shouldn't remove the association
+			Config: r.withPrivateEndpointTags(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (PrivateEndpointApplicationSecurityGroupAssociationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.PrivateEndpointApplicationSecurityGroupAssociationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.PrivateEndpointClient.Get(ctx, id.PrivateEndpoint.ResourceGroup, id.PrivateEndpoint.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id.PrivateEndpoint, err)
+	}
+
+	if props := resp.PrivateEndpointProperties; props != nil && props.ApplicationSecurityGroups != nil {
+		for _, group := range *props.ApplicationSecurityGroups {
+			if group.ID != nil && strings.EqualFold(*group.ID, id.ApplicationSecurityGroup.ID()) {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r PrivateEndpointApplicationSecurityGroupAssociationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_endpoint" "test" {
+  name                = "acctest-privatelink-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  subnet_id           = azurerm_subnet.endpoint.id
+
+  private_service_connection {
+    name                           = azurerm_private_link_service.test.name
+    is_manual_connection           = false
+    private_connection_resource_id = azurerm_private_link_service.test.id
+  }
+}
+
+resource "azurerm_private_endpoint_application_security_group_association" "test" {
+  private_endpoint_id           = azurerm_private_endpoint.test.id
+  application_security_group_id = azurerm_application_security_group.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r PrivateEndpointApplicationSecurityGroupAssociationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_endpoint_application_security_group_association" "import" {
+  private_endpoint_id           = azurerm_private_endpoint_application_security_group_association.test.private_endpoint_id
+  application_security_group_id = azurerm_private_endpoint_application_security_group_association.test.application_security_group_id
+}
+`, r.basic(data))
+}
+
+func (r PrivateEndpointApplicationSecurityGroupAssociationResource) withPrivateEndpointTags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_endpoint" "test" {
+  name                = "acctest-privatelink-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  subnet_id           = azurerm_subnet.endpoint.id
+
+  private_service_connection {
+    name                           = azurerm_private_link_service.test.name
+    is_manual_connection           = false
+    private_connection_resource_id = azurerm_private_link_service.test.id
+  }
+
+  tags = {
+    env = "TEST"
+  }
+}
+
+resource "azurerm_private_endpoint_application_security_group_association" "test" {
+  private_endpoint_id           = azurerm_private_endpoint.test.id
+  application_security_group_id = azurerm_application_security_group.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (PrivateEndpointApplicationSecurityGroupAssociationResource) template(data acceptance.TestData) string {
+	r := PrivateEndpointResource{}
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_security_group" "test" {
+  name                = "acctest-asg-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, r.template(data, r.serviceAutoApprove(data)), data.RandomInteger)
+}
@@ -168,7 +168,6 @@ func resourcePrivateEndpoint() *pluginsdk.Resource {
 			"ip_configuration": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
-				MaxItems: 1,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"name": {
@@ -189,10 +188,24 @@ func resourcePrivateEndpoint() *pluginsdk.Resource {
 							ForceNew:     true,
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
+						"member_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
 					},
 				},
 			},
 
+			"custom_network_interface_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
 			"custom_dns_configs": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -313,6 +326,10 @@ func resourcePrivateEndpointCreate(d *pluginsdk.ResourceData, meta interface{})
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
+	if v, ok := d.GetOk("custom_network_interface_name"); ok {
+		parameters.PrivateEndpointProperties.CustomNetworkInterfaceName = utils.String(v.(string))
+	}
+
 	err = validatePrivateLinkServiceId(*parameters.PrivateEndpointProperties.PrivateLinkServiceConnections)
 	if err != nil {
 		return err
@@ -322,6 +339,10 @@ func resourcePrivateEndpointCreate(d *pluginsdk.ResourceData, meta interface{})
 		return err
 	}
 
+	// Application Security Group associations are managed on the Private Endpoint by a separate resource, which takes the same lock
+	locks.ByName(id.Name, "azurerm_private_endpoint")
+	defer locks.UnlockByName(id.Name, "azurerm_private_endpoint")
+
 	cosmosDbResIds := getCosmosDbResIdInPrivateServiceConnections(parameters.PrivateEndpointProperties)
 	for _, cosmosDbResId := range cosmosDbResIds {
 		log.Printf("[DEBUG] Add Lock For Private Endpoint %q, lock name: %q", id.Name, cosmosDbResId)
@@ -463,6 +484,22 @@ func resourcePrivateEndpointUpdate(d *pluginsdk.ResourceData, meta interface{})
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
+	if v, ok := d.GetOk("custom_network_interface_name"); ok {
+		parameters.PrivateEndpointProperties.CustomNetworkInterfaceName = utils.String(v.(string))
+	}
+
+	locks.ByName(id.Name, "azurerm_private_endpoint")
+	defer locks.UnlockByName(id.Name, "azurerm_private_endpoint")
+
+	// Application Security Groups are managed using the `azurerm_private_endpoint_application_security_group_association` resource, so retain any existing associations
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving Private Endpoint %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+	if existing.PrivateEndpointProperties != nil {
+		parameters.PrivateEndpointProperties.ApplicationSecurityGroups = existing.PrivateEndpointProperties.ApplicationSecurityGroups
+	}
+
 	err = validatePrivateLinkServiceId(*parameters.PrivateEndpointProperties.PrivateLinkServiceConnections)
 	if err != nil {
 		return err
@@ -626,6 +663,12 @@ func resourcePrivateEndpointRead(d *pluginsdk.ResourceData, meta interface{}) er
 			subnetId = *props.Subnet.ID
 		}
 		d.Set("subnet_id", subnetId)
+
+		customNetworkInterfaceName := ""
+		if props.CustomNetworkInterfaceName != nil {
+			customNetworkInterfaceName = *props.CustomNetworkInterfaceName
+		}
+		d.Set("custom_network_interface_name", customNetworkInterfaceName)
 	}
 
 	privateDnsZoneConfigs := make([]interface{}, 0)
@@ -681,6 +724,10 @@ func resourcePrivateEndpointDelete(d *pluginsdk.ResourceData, meta interface{})
 			ManualPrivateLinkServiceConnections: expandPrivateLinkEndpointServiceConnection(privateServiceConnections, true),
 		},
 	}
+	// Application Security Group associations are managed on the Private Endpoint by a separate resource, which takes the same lock
+	locks.ByName(id.Name, "azurerm_private_endpoint")
+	defer locks.UnlockByName(id.Name, "azurerm_private_endpoint")
+
 	cosmosDbResIds := getCosmosDbResIdInPrivateServiceConnections(parameters.PrivateEndpointProperties)
 	for _, cosmosDbResId := range cosmosDbResIds {
 		locks.ByName(cosmosDbResId, "azurerm_private_endpoint")
@@ -747,13 +794,17 @@ func expandPrivateEndpointIPConfigurations(input []interface{}) *[]network.Priva
 		v := item.(map[string]interface{})
 		privateIPAddress := v["private_ip_address"].(string)
 		subResourceName := v["subresource_name"].(string)
+		memberName := v["member_name"].(string)
+		if memberName == "" {
+			memberName = subResourceName
+		}
 		name := v["name"].(string)
 		result := network.PrivateEndpointIPConfiguration{
 			Name: utils.String(name),
 			PrivateEndpointIPConfigurationProperties: &network.PrivateEndpointIPConfigurationProperties{
 				PrivateIPAddress: utils.String(privateIPAddress),
 				GroupID:          utils.String(subResourceName),
-				MemberName:       utils.String(subResourceName),
+				MemberName:       utils.String(memberName),
 			},
 		}
 		results = append(results, result)
@@ -773,6 +824,7 @@ func flattenPrivateEndpointIPConfigurations(ipConfigurations *[]network.PrivateE
 			"name":               item.Name,
 			"private_ip_address": item.PrivateIPAddress,
 			"subresource_name":   item.GroupID,
+			"member_name":        item.MemberName,
 		})
 	}
 
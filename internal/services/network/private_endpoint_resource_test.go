@@ -244,6 +244,23 @@ func TestAccPrivateEndpoint_updateToPrivateConnectionAlias(t *testing.T) {
 	})
 }
 
+func TestAccPrivateEndpoint_multipleStaticIpConfigurations(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_endpoint", "test")
+	r := PrivateEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.multipleStaticIpConfigurations(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ip_configuration.#").HasValue("2"),
+				check.That(data.ResourceName).Key("network_interface.0.name").HasValue(fmt.Sprintf("acctest-nic-%d", data.RandomInteger)),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t PrivateEndpointResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.PrivateEndpointID(state.ID)
 	if err != nil {
@@ -812,3 +829,78 @@ resource "azurerm_private_endpoint" "test" {
 }
 `, r.template(data, r.serviceAutoApprove(data)), count, data.RandomInteger)
 }
+
+func (PrivateEndpointResource) multipleStaticIpConfigurations(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-privatelink-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  address_space       = ["10.5.0.0/16"]
+}
+
+resource "azurerm_subnet" "endpoint" {
+  name                 = "acctestsnetendpoint-%[1]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.5.2.0/24"]
+
+  enforce_private_link_endpoint_network_policies = true
+}
+
+resource "azurerm_cosmosdb_account" "test" {
+  name                = "acctest-ca-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  offer_type          = "Standard"
+  kind                = "GlobalDocumentDB"
+
+  consistency_policy {
+    consistency_level = "Session"
+  }
+
+  geo_location {
+    location          = azurerm_resource_group.test.location
+    failover_priority = 0
+  }
+}
+
+resource "azurerm_private_endpoint" "test" {
+  name                          = "acctest-privatelink-%[1]d"
+  resource_group_name           = azurerm_resource_group.test.name
+  location                      = azurerm_resource_group.test.location
+  subnet_id                     = azurerm_subnet.endpoint.id
+  custom_network_interface_name = "acctest-nic-%[1]d"
+
+  private_service_connection {
+    name                           = "acctest-privatelink-%[1]d"
+    is_manual_connection           = false
+    private_connection_resource_id = azurerm_cosmosdb_account.test.id
+    subresource_names              = ["Sql"]
+  }
+
+  ip_configuration {
+    name               = "acctest-ipconfig-global"
+    private_ip_address = "10.5.2.10"
+    subresource_name   = "Sql"
+    member_name        = azurerm_cosmosdb_account.test.name
+  }
+
+  ip_configuration {
+    name               = "acctest-ipconfig-regional"
+    private_ip_address = "10.5.2.11"
+    subresource_name   = "Sql"
+    member_name        = "${azurerm_cosmosdb_account.test.name}-${azurerm_resource_group.test.location}"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
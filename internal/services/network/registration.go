@@ -37,10 +37,12 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azurerm_public_ips":                                dataSourcePublicIPs(),
 		"azurerm_public_ip_prefix":                          dataSourcePublicIpPrefix(),
 		"azurerm_route_filter":                              dataSourceRouteFilter(),
+		"azurerm_route_server_bgp_connection":               dataSourceRouteServerBgpConnection(),
 		"azurerm_route_table":                               dataSourceRouteTable(),
 		"azurerm_network_service_tags":                      dataSourceNetworkServiceTags(),
 		"azurerm_subnet":                                    dataSourceSubnet(),
 		"azurerm_virtual_hub":                               dataSourceVirtualHub(),
+		"azurerm_virtual_hub_effective_routes":              dataSourceVirtualHubEffectiveRoutes(),
 		"azurerm_virtual_network_gateway":                   dataSourceVirtualNetworkGateway(),
 		"azurerm_virtual_network_gateway_connection":        dataSourceVirtualNetworkGatewayConnection(),
 		"azurerm_virtual_network":                           dataSourceVirtualNetwork(),
@@ -57,6 +59,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_application_gateway":                      resourceApplicationGateway(),
 		"azurerm_application_security_group":               resourceApplicationSecurityGroup(),
 		"azurerm_bastion_host":                             resourceBastionHost(),
+		"azurerm_bastion_host_shareable_link":              resourceBastionHostShareableLink(),
 		"azurerm_express_route_circuit_connection":         resourceExpressRouteCircuitConnection(),
 		"azurerm_express_route_circuit_authorization":      resourceExpressRouteCircuitAuthorization(),
 		"azurerm_express_route_circuit_peering":            resourceExpressRouteCircuitPeering(),
@@ -79,47 +82,49 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_network_interface_nat_rule_association":                                 resourceNetworkInterfaceNatRuleAssociation(),
 		"azurerm_network_interface_security_group_association":                           resourceNetworkInterfaceSecurityGroupAssociation(),
 
-		"azurerm_network_packet_capture":                    resourceNetworkPacketCapture(),
-		"azurerm_network_profile":                           resourceNetworkProfile(),
-		"azurerm_point_to_site_vpn_gateway":                 resourcePointToSiteVPNGateway(),
-		"azurerm_private_endpoint":                          resourcePrivateEndpoint(),
-		"azurerm_private_link_service":                      resourcePrivateLinkService(),
-		"azurerm_public_ip":                                 resourcePublicIp(),
-		"azurerm_public_ip_prefix":                          resourcePublicIpPrefix(),
-		"azurerm_network_security_group":                    resourceNetworkSecurityGroup(),
-		"azurerm_network_security_rule":                     resourceNetworkSecurityRule(),
-		"azurerm_network_watcher_flow_log":                  resourceNetworkWatcherFlowLog(),
-		"azurerm_network_watcher":                           resourceNetworkWatcher(),
-		"azurerm_route_filter":                              resourceRouteFilter(),
-		"azurerm_route_table":                               resourceRouteTable(),
-		"azurerm_route":                                     resourceRoute(),
-		"azurerm_route_server":                              resourceRouteServer(),
-		"azurerm_route_server_bgp_connection":               resourceRouteServerBgpConnection(),
-		"azurerm_virtual_hub_security_partner_provider":     resourceVirtualHubSecurityPartnerProvider(),
-		"azurerm_subnet_service_endpoint_storage_policy":    resourceSubnetServiceEndpointStoragePolicy(),
-		"azurerm_subnet_network_security_group_association": resourceSubnetNetworkSecurityGroupAssociation(),
-		"azurerm_subnet_route_table_association":            resourceSubnetRouteTableAssociation(),
-		"azurerm_subnet_nat_gateway_association":            resourceSubnetNatGatewayAssociation(),
-		"azurerm_subnet":                                    resourceSubnet(),
-		"azurerm_virtual_hub":                               resourceVirtualHub(),
-		"azurerm_virtual_hub_bgp_connection":                resourceVirtualHubBgpConnection(),
-		"azurerm_virtual_hub_connection":                    resourceVirtualHubConnection(),
-		"azurerm_virtual_hub_ip":                            resourceVirtualHubIP(),
-		"azurerm_virtual_hub_route_table":                   resourceVirtualHubRouteTable(),
-		"azurerm_virtual_hub_route_table_route":             resourceVirtualHubRouteTableRoute(),
-		"azurerm_virtual_network_dns_servers":               resourceVirtualNetworkDnsServers(),
-		"azurerm_virtual_network_gateway_connection":        resourceVirtualNetworkGatewayConnection(),
-		"azurerm_virtual_network_gateway_nat_rule":          resourceVirtualNetworkGatewayNatRule(),
-		"azurerm_virtual_network_gateway":                   resourceVirtualNetworkGateway(),
-		"azurerm_virtual_network_peering":                   resourceVirtualNetworkPeering(),
-		"azurerm_virtual_network":                           resourceVirtualNetwork(),
-		"azurerm_virtual_wan":                               resourceVirtualWan(),
-		"azurerm_vpn_gateway":                               resourceVPNGateway(),
-		"azurerm_vpn_gateway_connection":                    resourceVPNGatewayConnection(),
-		"azurerm_vpn_gateway_nat_rule":                      resourceVPNGatewayNatRule(),
-		"azurerm_vpn_server_configuration":                  resourceVPNServerConfiguration(),
-		"azurerm_vpn_server_configuration_policy_group":     resourceVPNServerConfigurationPolicyGroup(),
-		"azurerm_vpn_site":                                  resourceVpnSite(),
-		"azurerm_web_application_firewall_policy":           resourceWebApplicationFirewallPolicy(),
+		"azurerm_network_packet_capture":                                  resourceNetworkPacketCapture(),
+		"azurerm_network_profile":                                         resourceNetworkProfile(),
+		"azurerm_point_to_site_vpn_gateway":                               resourcePointToSiteVPNGateway(),
+		"azurerm_private_endpoint":                                        resourcePrivateEndpoint(),
+		"azurerm_private_endpoint_application_security_group_association": resourcePrivateEndpointApplicationSecurityGroupAssociation(),
+		"azurerm_private_link_service":                                    resourcePrivateLinkService(),
+		"azurerm_public_ip":                                               resourcePublicIp(),
+		"azurerm_public_ip_prefix":                                        resourcePublicIpPrefix(),
+		"azurerm_network_security_group":                                  resourceNetworkSecurityGroup(),
+		"azurerm_network_security_rule":                                   resourceNetworkSecurityRule(),
+		"azurerm_network_watcher_flow_log":                                resourceNetworkWatcherFlowLog(),
+		"azurerm_network_watcher":                                         resourceNetworkWatcher(),
+		"azurerm_route_filter":                                            resourceRouteFilter(),
+		"azurerm_route_table":                                             resourceRouteTable(),
+		"azurerm_route":                                                   resourceRoute(),
+		"azurerm_route_server":                                            resourceRouteServer(),
+		"azurerm_route_server_bgp_connection":                             resourceRouteServerBgpConnection(),
+		"azurerm_virtual_hub_security_partner_provider":                   resourceVirtualHubSecurityPartnerProvider(),
+		"azurerm_subnet_service_endpoint_storage_policy":                  resourceSubnetServiceEndpointStoragePolicy(),
+		"azurerm_subnet_network_security_group_association":               resourceSubnetNetworkSecurityGroupAssociation(),
+		"azurerm_subnet_route_table_association":                          resourceSubnetRouteTableAssociation(),
+		"azurerm_subnet_nat_gateway_association":                          resourceSubnetNatGatewayAssociation(),
+		"azurerm_subnet":                                                  resourceSubnet(),
+		"azurerm_virtual_hub":                                             resourceVirtualHub(),
+		"azurerm_virtual_hub_bgp_connection":                              resourceVirtualHubBgpConnection(),
+		"azurerm_virtual_hub_connection":                                  resourceVirtualHubConnection(),
+		"azurerm_virtual_hub_ip":                                          resourceVirtualHubIP(),
+		"azurerm_virtual_hub_route_table":                                 resourceVirtualHubRouteTable(),
+		"azurerm_virtual_hub_routing_intent":                              resourceVirtualHubRoutingIntent(),
+		"azurerm_virtual_hub_route_table_route":                           resourceVirtualHubRouteTableRoute(),
+		"azurerm_virtual_network_dns_servers":                             resourceVirtualNetworkDnsServers(),
+		"azurerm_virtual_network_gateway_connection":                      resourceVirtualNetworkGatewayConnection(),
+		"azurerm_virtual_network_gateway_nat_rule":                        resourceVirtualNetworkGatewayNatRule(),
+		"azurerm_virtual_network_gateway":                                 resourceVirtualNetworkGateway(),
+		"azurerm_virtual_network_peering":                                 resourceVirtualNetworkPeering(),
+		"azurerm_virtual_network":                                         resourceVirtualNetwork(),
+		"azurerm_virtual_wan":                                             resourceVirtualWan(),
+		"azurerm_vpn_gateway":                                             resourceVPNGateway(),
+		"azurerm_vpn_gateway_connection":                                  resourceVPNGatewayConnection(),
+		"azurerm_vpn_gateway_nat_rule":                                    resourceVPNGatewayNatRule(),
+		"azurerm_vpn_server_configuration":                                resourceVPNServerConfiguration(),
+		"azurerm_vpn_server_configuration_policy_group":                   resourceVPNServerConfigurationPolicyGroup(),
+		"azurerm_vpn_site":                                                resourceVpnSite(),
+		"azurerm_web_application_firewall_policy":                         resourceWebApplicationFirewallPolicy(),
 	}
 }
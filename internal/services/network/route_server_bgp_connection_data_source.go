@@ -0,0 +1,276 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceRouteServerBgpConnection() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceRouteServerBgpConnectionRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"route_server_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.VirtualHubID,
+			},
+
+			"peer_asn": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"peer_ip": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"learned_route": routeServerBgpConnectionPeerRouteSchema(),
+
+			"advertised_route": routeServerBgpConnectionPeerRouteSchema(),
+		},
+	}
+}
+
+func routeServerBgpConnectionPeerRouteSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"as_path": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"local_address": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"network": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"next_hop": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"origin": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"source_peer": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"weight": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRouteServerBgpConnectionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualHubBgpConnectionClient
+	routesClient := meta.(*clients.Client).Network.VirtualHubBgpConnectionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	routeServerId, err := parse.VirtualHubID(d.Get("route_server_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewBgpConnectionID(routeServerId.SubscriptionId, routeServerId.ResourceGroup, routeServerId.Name, d.Get("name").(string))
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("%s was not found", id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	d.Set("name", id.Name)
+	d.Set("route_server_id", routeServerId.ID())
+
+	if props := resp.BgpConnectionProperties; props != nil {
+		d.Set("peer_asn", props.PeerAsn)
+		d.Set("peer_ip", props.PeerIP)
+	}
+
+	learnedFuture, err := routesClient.ListLearnedRoutes(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
+	if err != nil {
+		return fmt.Errorf("listing learned routes for %s: %+v", id, err)
+	}
+	learnedRoutes, err := listRouteServerBgpConnectionPeerRoutes(ctx, routesClient, learnedFuture.FutureAPI)
+	if err != nil {
+		return fmt.Errorf("listing learned routes for %s: %+v", id, err)
+	}
+	if err := d.Set("learned_route", flattenRouteServerBgpConnectionPeerRoutes(learnedRoutes)); err != nil {
+		return fmt.Errorf("setting `learned_route`: %+v", err)
+	}
+
+	advertisedFuture, err := routesClient.ListAdvertisedRoutes(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
+	if err != nil {
+		return fmt.Errorf("listing advertised routes for %s: %+v", id, err)
+	}
+	advertisedRoutes, err := listRouteServerBgpConnectionPeerRoutes(ctx, routesClient, advertisedFuture.FutureAPI)
+	if err != nil {
+		return fmt.Errorf("listing advertised routes for %s: %+v", id, err)
+	}
+	if err := d.Set("advertised_route", flattenRouteServerBgpConnectionPeerRoutes(advertisedRoutes)); err != nil {
+		return fmt.Errorf("setting `advertised_route`: %+v", err)
+	}
+
+	return nil
+}
+
+// listRouteServerBgpConnectionPeerRoutes waits for a learned/advertised routes operation to complete and returns the routes.
+// The API returns the routes keyed by the Route Server instance which reported them, rather than the flat list which is
+// modelled by `network.PeerRouteList` - as such we parse the (final) response ourselves and support both formats.
+func listRouteServerBgpConnectionPeerRoutes(ctx context.Context, client *network.VirtualHubBgpConnectionsClient, future azure.FutureAPI) ([]network.PeerRoute, error) {
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return nil, fmt.Errorf("waiting for completion: %+v", err)
+	}
+
+	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	resp, err := future.GetResult(sender)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving result: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNoContent {
+		return []network.PeerRoute{}, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %+v", err)
+	}
+
+	var result struct {
+		Value json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("unmarshalling response: %+v", err)
+	}
+
+	routes := make([]network.PeerRoute, 0)
+	if len(result.Value) == 0 || string(result.Value) == "null" {
+		return routes, nil
+	}
+
+	if err := json.Unmarshal(result.Value, &routes); err == nil {
+		return routes, nil
+	}
+
+	var routesByInstance map[string][]network.PeerRoute
+	if err := json.Unmarshal(result.Value, &routesByInstance); err != nil {
+		return nil, fmt.Errorf("unmarshalling routes: %+v", err)
+	}
+	// sort the instances so that the routes are returned in a consistent order
+	instances := make([]string, 0, len(routesByInstance))
+	for instance := range routesByInstance {
+		instances = append(instances, instance)
+	}
+	sort.Strings(instances)
+	for _, instance := range instances {
+		routes = append(routes, routesByInstance[instance]...)
+	}
+
+	return routes, nil
+}
+
+func flattenRouteServerBgpConnectionPeerRoutes(input []network.PeerRoute) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, item := range input {
+		asPath := ""
+		if item.AsPath != nil {
+			asPath = *item.AsPath
+		}
+
+		localAddress := ""
+		if item.LocalAddress != nil {
+			localAddress = *item.LocalAddress
+		}
+
+		networkPrefix := ""
+		if item.NetworkProperty != nil {
+			networkPrefix = *item.NetworkProperty
+		}
+
+		nextHop := ""
+		if item.NextHop != nil {
+			nextHop = *item.NextHop
+		}
+
+		origin := ""
+		if item.Origin != nil {
+			origin = *item.Origin
+		}
+
+		sourcePeer := ""
+		if item.SourcePeer != nil {
+			sourcePeer = *item.SourcePeer
+		}
+
+		weight := 0
+		if item.Weight != nil {
+			weight = int(*item.Weight)
+		}
+
+		results = append(results, map[string]interface{}{
+			"as_path":       asPath,
+			"local_address": localAddress,
+			"network":       networkPrefix,
+			"next_hop":      nextHop,
+			"origin":        origin,
+			"source_peer":   sourcePeer,
+			"weight":        weight,
+		})
+	}
+
+	return results
+}
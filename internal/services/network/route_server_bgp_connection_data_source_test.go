@@ -0,0 +1,39 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type RouteServerBgpConnectionDataSource struct{}
+
+func TestAccDataSourceRouteServerBgpConnection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_route_server_bgp_connection", "test")
+	r := RouteServerBgpConnectionDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("peer_asn").HasValue("65501"),
+				check.That(data.ResourceName).Key("peer_ip").HasValue("169.254.21.5"),
+				check.That(data.ResourceName).Key("learned_route.#").Exists(),
+				check.That(data.ResourceName).Key("advertised_route.#").Exists(),
+			),
+		},
+	})
+}
+
+func (RouteServerBgpConnectionDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_route_server_bgp_connection" "test" {
+  name            = azurerm_route_server_bgp_connection.test.name
+  route_server_id = azurerm_route_server_bgp_connection.test.route_server_id
+}
+`, RouteServerBGPConnectionResource{}.basic(data))
+}
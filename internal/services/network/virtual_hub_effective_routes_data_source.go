@@ -0,0 +1,198 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceVirtualHubEffectiveRoutes() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceVirtualHubEffectiveRoutesRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"virtual_hub_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.VirtualHubID,
+			},
+
+			"route_table_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.HubRouteTableID,
+			},
+
+			"effective_route": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"address_prefixes": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"next_hops": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"next_hop_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"as_path": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"route_origin": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVirtualHubEffectiveRoutesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualHubClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	virtualHubId, err := parse.VirtualHubID(d.Get("virtual_hub_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// Routing Intent is applied to the Default Route Table of the Virtual Hub, so use that unless told otherwise
+	id := parse.NewHubRouteTableID(virtualHubId.SubscriptionId, virtualHubId.ResourceGroup, virtualHubId.Name, "defaultRouteTable")
+	if v := d.Get("route_table_id").(string); v != "" {
+		routeTableId, err := parse.HubRouteTableID(v)
+		if err != nil {
+			return err
+		}
+		if routeTableId.VirtualHubName != virtualHubId.Name || routeTableId.ResourceGroup != virtualHubId.ResourceGroup {
+			return fmt.Errorf("`route_table_id` must be a Route Table within the Virtual Hub %q", virtualHubId.ID())
+		}
+		id = *routeTableId
+	}
+
+	future, err := client.GetEffectiveVirtualHubRoutes(ctx, id.ResourceGroup, id.VirtualHubName, &network.EffectiveRoutesParameters{
+		ResourceID:             utils.String(id.ID()),
+		VirtualWanResourceType: utils.String("RouteTable"),
+	})
+	if err != nil {
+		return fmt.Errorf("retrieving effective routes for %s: %+v", id, err)
+	}
+	effectiveRoutes, err := getVirtualHubEffectiveRoutes(ctx, client, future)
+	if err != nil {
+		return fmt.Errorf("retrieving effective routes for %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	d.Set("virtual_hub_id", virtualHubId.ID())
+	d.Set("route_table_id", id.ID())
+
+	if err := d.Set("effective_route", flattenVirtualHubEffectiveRoutes(effectiveRoutes)); err != nil {
+		return fmt.Errorf("setting `effective_route`: %+v", err)
+	}
+
+	return nil
+}
+
+// getVirtualHubEffectiveRoutes waits for the effective routes operation to complete and returns the routes, since the
+// SDK doesn't unmarshal the (final) response of this operation
+func getVirtualHubEffectiveRoutes(ctx context.Context, client *network.VirtualHubsClient, future network.VirtualHubsGetEffectiveVirtualHubRoutesFuture) ([]network.VirtualHubEffectiveRoute, error) {
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return nil, fmt.Errorf("waiting for completion: %+v", err)
+	}
+
+	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	resp, err := future.GetResult(sender)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving result: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNoContent {
+		return []network.VirtualHubEffectiveRoute{}, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %+v", err)
+	}
+
+	var result network.VirtualHubEffectiveRouteList
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("unmarshalling response: %+v", err)
+	}
+	if result.Value == nil {
+		return []network.VirtualHubEffectiveRoute{}, nil
+	}
+
+	return *result.Value, nil
+}
+
+func flattenVirtualHubEffectiveRoutes(input []network.VirtualHubEffectiveRoute) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, item := range input {
+		nextHopType := ""
+		if item.NextHopType != nil {
+			nextHopType = *item.NextHopType
+		}
+
+		asPath := ""
+		if item.AsPath != nil {
+			asPath = *item.AsPath
+		}
+
+		routeOrigin := ""
+		if item.RouteOrigin != nil {
+			routeOrigin = *item.RouteOrigin
+		}
+
+		results = append(results, map[string]interface{}{
+			"address_prefixes": utils.FlattenStringSlice(item.AddressPrefixes),
+			"next_hops":        utils.FlattenStringSlice(item.NextHops),
+			"next_hop_type":    nextHopType,
+			"as_path":          asPath,
+			"route_origin":     routeOrigin,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,36 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type VirtualHubEffectiveRoutesDataSource struct{}
+
+func TestAccDataSourceVirtualHubEffectiveRoutes_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_hub_effective_routes", "test")
+	r := VirtualHubEffectiveRoutesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("route_table_id").Exists(),
+				check.That(data.ResourceName).Key("effective_route.#").Exists(),
+			),
+		},
+	})
+}
+
+func (VirtualHubEffectiveRoutesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_hub_effective_routes" "test" {
+  virtual_hub_id = azurerm_virtual_hub_routing_intent.test.virtual_hub_id
+}
+`, VirtualHubRoutingIntentResource{}.basic(data))
+}
@@ -560,7 +560,11 @@ func getVirtualNetworkGatewayProperties(id parse.VirtualNetworkGatewayId, d *plu
 	}
 
 	if _, ok := d.GetOk("vpn_client_configuration"); ok {
-		props.VpnClientConfiguration = expandVirtualNetworkGatewayVpnClientConfig(d)
+		vpnClientConfig, err := expandVirtualNetworkGatewayVpnClientConfig(d)
+		if err != nil {
+			return nil, err
+		}
+		props.VpnClientConfiguration = vpnClientConfig
 	}
 
 	if _, ok := d.GetOk("bgp_settings"); ok {
@@ -711,7 +715,7 @@ func expandVirtualNetworkGatewayIPConfigurations(d *pluginsdk.ResourceData) *[]n
 	return &ipConfigs
 }
 
-func expandVirtualNetworkGatewayVpnClientConfig(d *pluginsdk.ResourceData) *network.VpnClientConfiguration {
+func expandVirtualNetworkGatewayVpnClientConfig(d *pluginsdk.ResourceData) (*network.VpnClientConfiguration, error) {
 	configSets := d.Get("vpn_client_configuration").([]interface{})
 	conf := configSets[0].(map[string]interface{})
 
@@ -765,6 +769,25 @@ func expandVirtualNetworkGatewayVpnClientConfig(d *pluginsdk.ResourceData) *netw
 	var vpnAuthTypes []network.VpnAuthenticationType
 	for _, vpnAuthType := range conf["vpn_auth_types"].(*pluginsdk.Set).List() {
 		a := network.VpnAuthenticationType(vpnAuthType.(string))
+
+		// each of the authentication types can be combined, so validate the settings required by each one
+		switch a {
+		case network.VpnAuthenticationTypeAAD:
+			if confAadTenant == "" || confAadAudience == "" || confAadIssuer == "" {
+				return nil, fmt.Errorf("`aad_tenant`, `aad_audience` and `aad_issuer` must be specified when `vpn_auth_types` contains `AAD`")
+			}
+
+		case network.VpnAuthenticationTypeCertificate:
+			if len(rootCerts) == 0 {
+				return nil, fmt.Errorf("`root_certificate` must be specified when `vpn_auth_types` contains `Certificate`")
+			}
+
+		case network.VpnAuthenticationTypeRadius:
+			if confRadiusServerAddress == "" || confRadiusServerSecret == "" {
+				return nil, fmt.Errorf("`radius_server_address` and `radius_server_secret` must be specified when `vpn_auth_types` contains `Radius`")
+			}
+		}
+
 		vpnAuthTypes = append(vpnAuthTypes, a)
 	}
 
@@ -781,7 +804,7 @@ func expandVirtualNetworkGatewayVpnClientConfig(d *pluginsdk.ResourceData) *netw
 		RadiusServerAddress:          &confRadiusServerAddress,
 		RadiusServerSecret:           &confRadiusServerSecret,
 		VpnAuthenticationTypes:       &vpnAuthTypes,
-	}
+	}, nil
 }
 
 func expandVirtualNetworkGatewaySku(d *pluginsdk.ResourceData) *network.VirtualNetworkGatewaySku {
@@ -0,0 +1,135 @@
+package relay
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2017-04-01/hybridconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	appServiceParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	appServiceValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceRelayHybridConnection() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceRelayHybridConnectionRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"relay_namespace_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"service_plan_ids": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: appServiceValidate.ServicePlanID,
+				},
+			},
+
+			"app_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"requires_client_authorization": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"user_metadata": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"listener_count": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceRelayHybridConnectionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Relay.HybridConnectionsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := hybridconnections.NewHybridConnectionID(subscriptionId, d.Get("resource_group_name").(string), d.Get("relay_namespace_name").(string), d.Get("name").(string))
+
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("%s was not found", id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	d.Set("name", id.HybridConnectionName)
+	d.Set("relay_namespace_name", id.NamespaceName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("requires_client_authorization", props.RequiresClientAuthorization)
+			d.Set("user_metadata", props.UserMetadata)
+
+			listenerCount := 0
+			if props.ListenerCount != nil {
+				listenerCount = int(*props.ListenerCount)
+			}
+			d.Set("listener_count", listenerCount)
+		}
+	}
+
+	// the Relay API doesn't expose which Apps are using the Hybrid Connection, so we query each of the specified App Service Plans for them
+	servicePlansClient := meta.(*clients.Client).AppService.ServicePlanClient
+	appIds := make([]string, 0)
+	for _, raw := range d.Get("service_plan_ids").([]interface{}) {
+		servicePlanId, err := appServiceParse.ServicePlanID(raw.(string))
+		if err != nil {
+			return err
+		}
+
+		iter, err := servicePlansClient.ListWebAppsByHybridConnectionComplete(ctx, servicePlanId.ResourceGroup, servicePlanId.ServerfarmName, id.NamespaceName, id.HybridConnectionName)
+		if err != nil {
+			return fmt.Errorf("listing Apps using %s in %s: %+v", id, servicePlanId, err)
+		}
+		for iter.NotDone() {
+			appIds = append(appIds, iter.Value())
+			if err := iter.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("listing Apps using %s in %s: %+v", id, servicePlanId, err)
+			}
+		}
+	}
+	if err := d.Set("app_ids", appIds); err != nil {
+		return fmt.Errorf("setting `app_ids`: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package relay_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type RelayHybridConnectionDataSource struct{}
+
+func TestAccDataSourceRelayHybridConnection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_relay_hybrid_connection", "test")
+	r := RelayHybridConnectionDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("id").Exists(),
+				check.That(data.ResourceName).Key("requires_client_authorization").HasValue("true"),
+				check.That(data.ResourceName).Key("user_metadata").HasValue("metadatatest"),
+				check.That(data.ResourceName).Key("listener_count").HasValue("0"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceRelayHybridConnection_apps(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_relay_hybrid_connection", "test")
+	r := RelayHybridConnectionDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.apps(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("app_ids.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (RelayHybridConnectionDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_relay_hybrid_connection" "test" {
+  name                 = azurerm_relay_hybrid_connection.test.name
+  resource_group_name  = azurerm_relay_hybrid_connection.test.resource_group_name
+  relay_namespace_name = azurerm_relay_hybrid_connection.test.relay_namespace_name
+}
+`, RelayHybridConnectionResource{}.full(data))
+}
+
+func (RelayHybridConnectionDataSource) apps(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_service_plan" "test" {
+  name                = "acctestASP-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  os_type             = "Windows"
+  sku_name            = "B1"
+}
+
+resource "azurerm_relay_namespace" "test" {
+  name                = "acctest-RN-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku_name = "Standard"
+}
+
+resource "azurerm_relay_hybrid_connection" "test" {
+  name                 = "acctest-RHC-%[1]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  relay_namespace_name = azurerm_relay_namespace.test.name
+}
+
+resource "azurerm_windows_web_app" "test" {
+  name                = "acctestWA-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  site_config {}
+}
+
+resource "azurerm_web_app_hybrid_connection" "test" {
+  web_app_id = azurerm_windows_web_app.test.id
+  relay_id   = azurerm_relay_hybrid_connection.test.id
+  hostname   = "acctest%[3]s.hostname"
+  port       = 8081
+}
+
+data "azurerm_relay_hybrid_connection" "test" {
+  name                 = azurerm_relay_hybrid_connection.test.name
+  resource_group_name  = azurerm_relay_hybrid_connection.test.resource_group_name
+  relay_namespace_name = azurerm_relay_hybrid_connection.test.relay_namespace_name
+  service_plan_ids     = [azurerm_service_plan.test.id]
+
+  depends_on = [azurerm_web_app_hybrid_connection.test]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomStringOfLength(8))
+}
@@ -7,12 +7,14 @@ import (
 )
 
 type Client struct {
-	AlertRulesClient         *securityinsight.AlertRulesClient
-	AlertRuleTemplatesClient *alertruletemplates.AlertRuleTemplatesClient
-	AutomationRulesClient    *securityinsight.AutomationRulesClient
-	DataConnectorsClient     *securityinsight.DataConnectorsClient
-	WatchlistsClient         *securityinsight.WatchlistsClient
-	WatchlistItemsClient     *securityinsight.WatchlistItemsClient
+	AlertRulesClient                   *securityinsight.AlertRulesClient
+	AlertRuleTemplatesClient           *alertruletemplates.AlertRuleTemplatesClient
+	AutomationRulesClient              *securityinsight.AutomationRulesClient
+	DataConnectorsClient               *securityinsight.DataConnectorsClient
+	ProductSettingsClient              *securityinsight.ProductSettingsClient
+	ThreatIntelligenceIndicatorsClient *securityinsight.ThreatIntelligenceIndicatorClient
+	WatchlistsClient                   *securityinsight.WatchlistsClient
+	WatchlistItemsClient               *securityinsight.WatchlistItemsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -28,6 +30,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	dataConnectorsClient := securityinsight.NewDataConnectorsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&dataConnectorsClient.Client, o.ResourceManagerAuthorizer)
 
+	productSettingsClient := securityinsight.NewProductSettingsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&productSettingsClient.Client, o.ResourceManagerAuthorizer)
+
+	threatIntelligenceIndicatorsClient := securityinsight.NewThreatIntelligenceIndicatorClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&threatIntelligenceIndicatorsClient.Client, o.ResourceManagerAuthorizer)
+
 	watchListsClient := securityinsight.NewWatchlistsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&watchListsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -35,11 +43,13 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&watchListItemsClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		AlertRulesClient:         &alertRulesClient,
-		AlertRuleTemplatesClient: &alertRuleTemplatesClient,
-		AutomationRulesClient:    &automationRulesClient,
-		DataConnectorsClient:     &dataConnectorsClient,
-		WatchlistsClient:         &watchListsClient,
-		WatchlistItemsClient:     &watchListItemsClient,
+		AlertRulesClient:                   &alertRulesClient,
+		AlertRuleTemplatesClient:           &alertRuleTemplatesClient,
+		AutomationRulesClient:              &automationRulesClient,
+		DataConnectorsClient:               &dataConnectorsClient,
+		ProductSettingsClient:              &productSettingsClient,
+		ThreatIntelligenceIndicatorsClient: &threatIntelligenceIndicatorsClient,
+		WatchlistsClient:                   &watchListsClient,
+		WatchlistItemsClient:               &watchListItemsClient,
 	}
 }
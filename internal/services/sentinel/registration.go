@@ -62,5 +62,8 @@ func (r Registration) Resources() []sdk.Resource {
 		WatchlistResource{},
 		WatchlistItemResource{},
 		DataConnectorAwsS3Resource{},
+		EntityAnalyticsSettingResource{},
+		UebaSettingResource{},
+		ThreatIntelligenceIndicatorResource{},
 	}
 }
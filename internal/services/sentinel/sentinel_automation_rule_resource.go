@@ -97,6 +97,7 @@ func resourceSentinelAutomationRule() *pluginsdk.Resource {
 								string(securityinsight.AutomationRulePropertyConditionSupportedPropertyAccountPUID),
 								string(securityinsight.AutomationRulePropertyConditionSupportedPropertyAccountSid),
 								string(securityinsight.AutomationRulePropertyConditionSupportedPropertyAccountUPNSuffix),
+								string(securityinsight.AutomationRulePropertyConditionSupportedPropertyAlertProductNames),
 								string(securityinsight.AutomationRulePropertyConditionSupportedPropertyAzureResourceResourceID),
 								string(securityinsight.AutomationRulePropertyConditionSupportedPropertyAzureResourceSubscriptionID),
 								string(securityinsight.AutomationRulePropertyConditionSupportedPropertyCloudApplicationAppID),
@@ -112,6 +113,7 @@ func resourceSentinelAutomationRule() *pluginsdk.Resource {
 								string(securityinsight.AutomationRulePropertyConditionSupportedPropertyHostOSVersion),
 								string(securityinsight.AutomationRulePropertyConditionSupportedPropertyIPAddress),
 								string(securityinsight.AutomationRulePropertyConditionSupportedPropertyIncidentDescription),
+								string(securityinsight.AutomationRulePropertyConditionSupportedPropertyIncidentLabel),
 								string(securityinsight.AutomationRulePropertyConditionSupportedPropertyIncidentProviderName),
 								string(securityinsight.AutomationRulePropertyConditionSupportedPropertyIncidentRelatedAnalyticRuleIds),
 								string(securityinsight.AutomationRulePropertyConditionSupportedPropertyIncidentSeverity),
@@ -153,6 +153,12 @@ resource "azurerm_sentinel_automation_rule" "test" {
     values   = ["c", "d"]
   }
 
+  condition {
+    property = "IncidentLabel"
+    operator = "Equals"
+    values   = ["triage"]
+  }
+
   action_incident {
     order                  = 1
     status                 = "Closed"
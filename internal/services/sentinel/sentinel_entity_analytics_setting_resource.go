@@ -0,0 +1,160 @@
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2022-01-01-preview/securityinsight"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// the name of the Setting is fixed, there can only be one per Workspace
+const sentinelEntityAnalyticsSettingName = "EntityAnalytics"
+
+type EntityAnalyticsSettingResource struct{}
+
+var _ sdk.Resource = EntityAnalyticsSettingResource{}
+
+type EntityAnalyticsSettingModel struct {
+	LogAnalyticsWorkspaceId string `tfschema:"log_analytics_workspace_id"`
+}
+
+func (r EntityAnalyticsSettingResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"log_analytics_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+	}
+}
+
+func (r EntityAnalyticsSettingResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r EntityAnalyticsSettingResource) ResourceType() string {
+	return "azurerm_sentinel_entity_analytics_setting"
+}
+
+func (r EntityAnalyticsSettingResource) ModelObject() interface{} {
+	return &EntityAnalyticsSettingModel{}
+}
+
+func (r EntityAnalyticsSettingResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.SettingID
+}
+
+func (r EntityAnalyticsSettingResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ProductSettingsClient
+
+			var model EntityAnalyticsSettingModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(model.LogAnalyticsWorkspaceId)
+			if err != nil {
+				return fmt.Errorf("parsing Log Analytics Workspace ID: %w", err)
+			}
+
+			id := parse.NewSettingID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, sentinelEntityAnalyticsSettingName)
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.Name)
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				// a disabled Setting is treated as gone by Read, so only an enabled one needs to be imported
+				if entityAnalytics, ok := existing.Value.AsEntityAnalytics(); ok && !sentinelEntityAnalyticsSettingIsDisabled(entityAnalytics) {
+					return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				}
+			}
+
+			// Entity Analytics is enabled by the presence of the Setting, `isEnabled` is read-only
+			param := securityinsight.EntityAnalytics{
+				EntityAnalyticsProperties: &securityinsight.EntityAnalyticsProperties{},
+			}
+
+			if _, err := client.Update(ctx, id.ResourceGroup, id.WorkspaceName, id.Name, param); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EntityAnalyticsSettingResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ProductSettingsClient
+
+			id, err := parse.SettingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.Name)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			entityAnalytics, ok := resp.Value.AsEntityAnalytics()
+			if !ok {
+				return fmt.Errorf("retrieving %s: expected a Setting of kind %q", id, securityinsight.KindBasicSettingsKindEntityAnalytics)
+			}
+			if sentinelEntityAnalyticsSettingIsDisabled(entityAnalytics) {
+				return metadata.MarkAsGone(id)
+			}
+
+			model := EntityAnalyticsSettingModel{
+				LogAnalyticsWorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName).ID(),
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r EntityAnalyticsSettingResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ProductSettingsClient
+
+			id, err := parse.SettingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, id.ResourceGroup, id.WorkspaceName, id.Name); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func sentinelEntityAnalyticsSettingIsDisabled(input *securityinsight.EntityAnalytics) bool {
+	props := input.EntityAnalyticsProperties
+	return props != nil && props.IsEnabled != nil && !*props.IsEnabled
+}
@@ -0,0 +1,87 @@
+package sentinel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type EntityAnalyticsSettingResource struct{}
+
+func TestAccEntityAnalyticsSetting_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_entity_analytics_setting", "test")
+	r := EntityAnalyticsSettingResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEntityAnalyticsSetting_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_entity_analytics_setting", "test")
+	r := EntityAnalyticsSettingResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r EntityAnalyticsSettingResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Sentinel.ProductSettingsClient
+
+	id, err := parse.SettingID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.Name); err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r EntityAnalyticsSettingResource) basic(data acceptance.TestData) string {
+	template := WatchlistResource{}.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_entity_analytics_setting" "test" {
+  log_analytics_workspace_id = azurerm_log_analytics_solution.sentinel.workspace_resource_id
+}
+`, template)
+}
+
+func (r EntityAnalyticsSettingResource) requiresImport(data acceptance.TestData) string {
+	template := r.basic(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_entity_analytics_setting" "import" {
+  log_analytics_workspace_id = azurerm_sentinel_entity_analytics_setting.test.log_analytics_workspace_id
+}
+`, template)
+}
@@ -0,0 +1,566 @@
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2022-01-01-preview/securityinsight"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// threatIntelligenceName is the name of the (only) Threat Intelligence container within a Sentinel Workspace
+const threatIntelligenceName = "main"
+
+type ThreatIntelligenceIndicatorResource struct{}
+
+var _ sdk.ResourceWithUpdate = ThreatIntelligenceIndicatorResource{}
+
+type ThreatIntelligenceIndicatorModel struct {
+	LogAnalyticsWorkspaceId string                                     `tfschema:"log_analytics_workspace_id"`
+	DisplayName             string                                     `tfschema:"display_name"`
+	Pattern                 string                                     `tfschema:"pattern"`
+	PatternType             string                                     `tfschema:"pattern_type"`
+	PatternVersion          string                                     `tfschema:"pattern_version"`
+	Source                  string                                     `tfschema:"source"`
+	ValidFromUtc            string                                     `tfschema:"validate_from_utc"`
+	ValidUntilUtc           string                                     `tfschema:"validate_until_utc"`
+	Confidence              int                                        `tfschema:"confidence"`
+	CreatedBy               string                                     `tfschema:"created_by"`
+	Description             string                                     `tfschema:"description"`
+	Language                string                                     `tfschema:"language"`
+	Revoked                 bool                                       `tfschema:"revoked"`
+	Tags                    []string                                   `tfschema:"tags"`
+	ThreatTypes             []string                                   `tfschema:"threat_types"`
+	KillChainPhases         []ThreatIntelligenceKillChainPhaseModel    `tfschema:"kill_chain_phase"`
+	ExternalReferences      []ThreatIntelligenceExternalReferenceModel `tfschema:"external_reference"`
+	Guid                    string                                     `tfschema:"guid"`
+	CreatedOn               string                                     `tfschema:"created_on"`
+	LastUpdatedTimeUtc      string                                     `tfschema:"last_updated_time_utc"`
+	Defanged                bool                                       `tfschema:"defanged"`
+	ExternalId              string                                     `tfschema:"external_id"`
+	IndicatorTypes          []string                                   `tfschema:"indicator_types"`
+}
+
+type ThreatIntelligenceKillChainPhaseModel struct {
+	Name      string `tfschema:"name"`
+	PhaseName string `tfschema:"phase_name"`
+}
+
+type ThreatIntelligenceExternalReferenceModel struct {
+	Description string            `tfschema:"description"`
+	Hashes      map[string]string `tfschema:"hashes"`
+	SourceName  string            `tfschema:"source_name"`
+	Url         string            `tfschema:"url"`
+	Id          string            `tfschema:"id"`
+}
+
+func (r ThreatIntelligenceIndicatorResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"log_analytics_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"pattern": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"pattern_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"domain-name",
+				"file",
+				"ipv4-addr",
+				"ipv6-addr",
+				"url",
+			}, false),
+		},
+
+		"source": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"validate_from_utc": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			DiffSuppressFunc: suppress.RFC3339Time,
+			ValidateFunc:     validation.IsRFC3339Time,
+		},
+
+		"validate_until_utc": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			DiffSuppressFunc: suppress.RFC3339Time,
+			ValidateFunc:     validation.IsRFC3339Time,
+		},
+
+		"confidence": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(0, 100),
+		},
+
+		"created_by": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"language": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"pattern_version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"revoked": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"tags": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"threat_types": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"kill_chain_phase": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"phase_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"external_reference": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"description": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"hashes": {
+						Type:     pluginsdk.TypeMap,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"source_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"url": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ThreatIntelligenceIndicatorResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"guid": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"created_on": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"last_updated_time_utc": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"defanged": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"external_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"indicator_types": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r ThreatIntelligenceIndicatorResource) ResourceType() string {
+	return "azurerm_sentinel_threat_intelligence_indicator"
+}
+
+func (r ThreatIntelligenceIndicatorResource) ModelObject() interface{} {
+	return &ThreatIntelligenceIndicatorModel{}
+}
+
+func (r ThreatIntelligenceIndicatorResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ThreatIntelligenceIndicatorID
+}
+
+func (r ThreatIntelligenceIndicatorResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ThreatIntelligenceIndicatorsClient
+
+			var model ThreatIntelligenceIndicatorModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(model.LogAnalyticsWorkspaceId)
+			if err != nil {
+				return fmt.Errorf("parsing Log Analytics Workspace ID: %w", err)
+			}
+
+			// the name of the Indicator is generated by the API, as such there's no requires import check here
+			resp, err := client.CreateIndicator(ctx, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, expandThreatIntelligenceIndicator(metadata, model))
+			if err != nil {
+				return fmt.Errorf("creating Threat Intelligence Indicator in %s: %+v", *workspaceId, err)
+			}
+
+			indicator, ok := resp.Value.AsThreatIntelligenceIndicatorModel()
+			if !ok || indicator.Name == nil {
+				return fmt.Errorf("creating Threat Intelligence Indicator in %s: `name` was nil", *workspaceId)
+			}
+
+			id := parse.NewThreatIntelligenceIndicatorID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, threatIntelligenceName, *indicator.Name)
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ThreatIntelligenceIndicatorResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ThreatIntelligenceIndicatorsClient
+
+			id, err := parse.ThreatIntelligenceIndicatorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.IndicatorName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			indicator, ok := resp.Value.AsThreatIntelligenceIndicatorModel()
+			if !ok {
+				return fmt.Errorf("retrieving %s: expected an Indicator", id)
+			}
+
+			model := ThreatIntelligenceIndicatorModel{
+				LogAnalyticsWorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName).ID(),
+				Guid:                    id.IndicatorName,
+			}
+
+			if props := indicator.ThreatIntelligenceIndicatorProperties; props != nil {
+				model.DisplayName = utils.NormalizeNilableString(props.DisplayName)
+				model.Pattern = utils.NormalizeNilableString(props.Pattern)
+				model.PatternType = utils.NormalizeNilableString(props.PatternType)
+				model.PatternVersion = utils.NormalizeNilableString(props.PatternVersion)
+				model.Source = utils.NormalizeNilableString(props.Source)
+				model.ValidFromUtc = utils.NormalizeNilableString(props.ValidFrom)
+				model.ValidUntilUtc = utils.NormalizeNilableString(props.ValidUntil)
+				model.CreatedBy = utils.NormalizeNilableString(props.CreatedByRef)
+				model.Description = utils.NormalizeNilableString(props.Description)
+				model.Language = utils.NormalizeNilableString(props.Language)
+				model.CreatedOn = utils.NormalizeNilableString(props.Created)
+				model.LastUpdatedTimeUtc = utils.NormalizeNilableString(props.LastUpdatedTimeUtc)
+				model.ExternalId = utils.NormalizeNilableString(props.ExternalID)
+
+				if props.Confidence != nil {
+					model.Confidence = int(*props.Confidence)
+				}
+				if props.Revoked != nil {
+					model.Revoked = *props.Revoked
+				}
+				if props.Defanged != nil {
+					model.Defanged = *props.Defanged
+				}
+				if props.ThreatIntelligenceTags != nil {
+					model.Tags = *props.ThreatIntelligenceTags
+				}
+				if props.ThreatTypes != nil {
+					model.ThreatTypes = *props.ThreatTypes
+				}
+				if props.IndicatorTypes != nil {
+					model.IndicatorTypes = *props.IndicatorTypes
+				}
+
+				model.KillChainPhases = flattenThreatIntelligenceKillChainPhases(props.KillChainPhases)
+				model.ExternalReferences = flattenThreatIntelligenceExternalReferences(props.ExternalReferences)
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r ThreatIntelligenceIndicatorResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ThreatIntelligenceIndicatorsClient
+
+			id, err := parse.ThreatIntelligenceIndicatorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ThreatIntelligenceIndicatorModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.IndicatorName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			indicator, ok := existing.Value.AsThreatIntelligenceIndicatorModel()
+			if !ok {
+				return fmt.Errorf("retrieving %s: expected an Indicator", id)
+			}
+
+			param := expandThreatIntelligenceIndicator(metadata, model)
+			param.Etag = indicator.Etag
+
+			if _, err := client.Create(ctx, id.ResourceGroup, id.WorkspaceName, id.IndicatorName, param); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ThreatIntelligenceIndicatorResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ThreatIntelligenceIndicatorsClient
+
+			id, err := parse.ThreatIntelligenceIndicatorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, id.ResourceGroup, id.WorkspaceName, id.IndicatorName); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandThreatIntelligenceIndicator(metadata sdk.ResourceMetaData, model ThreatIntelligenceIndicatorModel) securityinsight.ThreatIntelligenceIndicatorModel {
+	props := &securityinsight.ThreatIntelligenceIndicatorProperties{
+		DisplayName:            utils.String(model.DisplayName),
+		Pattern:                utils.String(model.Pattern),
+		PatternType:            utils.String(model.PatternType),
+		Source:                 utils.String(model.Source),
+		ValidFrom:              utils.String(model.ValidFromUtc),
+		Revoked:                utils.Bool(model.Revoked),
+		ThreatIntelligenceTags: &model.Tags,
+		ThreatTypes:            &model.ThreatTypes,
+		KillChainPhases:        expandThreatIntelligenceKillChainPhases(model.KillChainPhases),
+		ExternalReferences:     expandThreatIntelligenceExternalReferences(model.ExternalReferences),
+	}
+
+	if model.PatternVersion != "" {
+		props.PatternVersion = utils.String(model.PatternVersion)
+	}
+	if model.ValidUntilUtc != "" {
+		props.ValidUntil = utils.String(model.ValidUntilUtc)
+	}
+	if _, ok := metadata.ResourceData.GetOk("confidence"); ok {
+		props.Confidence = utils.Int32(int32(model.Confidence))
+	}
+	if model.CreatedBy != "" {
+		props.CreatedByRef = utils.String(model.CreatedBy)
+	}
+	if model.Description != "" {
+		props.Description = utils.String(model.Description)
+	}
+	if model.Language != "" {
+		props.Language = utils.String(model.Language)
+	}
+
+	return securityinsight.ThreatIntelligenceIndicatorModel{
+		Kind:                                  securityinsight.KindBasicThreatIntelligenceInformationKindIndicator,
+		ThreatIntelligenceIndicatorProperties: props,
+	}
+}
+
+func expandThreatIntelligenceKillChainPhases(input []ThreatIntelligenceKillChainPhaseModel) *[]securityinsight.ThreatIntelligenceKillChainPhase {
+	results := make([]securityinsight.ThreatIntelligenceKillChainPhase, 0)
+	for _, item := range input {
+		results = append(results, securityinsight.ThreatIntelligenceKillChainPhase{
+			KillChainName: utils.String(item.Name),
+			PhaseName:     utils.String(item.PhaseName),
+		})
+	}
+
+	return &results
+}
+
+func flattenThreatIntelligenceKillChainPhases(input *[]securityinsight.ThreatIntelligenceKillChainPhase) []ThreatIntelligenceKillChainPhaseModel {
+	results := make([]ThreatIntelligenceKillChainPhaseModel, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, ThreatIntelligenceKillChainPhaseModel{
+			Name:      utils.NormalizeNilableString(item.KillChainName),
+			PhaseName: utils.NormalizeNilableString(item.PhaseName),
+		})
+	}
+
+	return results
+}
+
+func expandThreatIntelligenceExternalReferences(input []ThreatIntelligenceExternalReferenceModel) *[]securityinsight.ThreatIntelligenceExternalReference {
+	results := make([]securityinsight.ThreatIntelligenceExternalReference, 0)
+	for _, item := range input {
+		reference := securityinsight.ThreatIntelligenceExternalReference{}
+
+		if item.Description != "" {
+			reference.Description = utils.String(item.Description)
+		}
+		if item.SourceName != "" {
+			reference.SourceName = utils.String(item.SourceName)
+		}
+		if item.Url != "" {
+			reference.URL = utils.String(item.Url)
+		}
+		if len(item.Hashes) != 0 {
+			hashes := make(map[string]*string)
+			for k, v := range item.Hashes {
+				hashes[k] = utils.String(v)
+			}
+			reference.Hashes = hashes
+		}
+
+		results = append(results, reference)
+	}
+
+	return &results
+}
+
+func flattenThreatIntelligenceExternalReferences(input *[]securityinsight.ThreatIntelligenceExternalReference) []ThreatIntelligenceExternalReferenceModel {
+	results := make([]ThreatIntelligenceExternalReferenceModel, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		hashes := make(map[string]string)
+		for k, v := range item.Hashes {
+			if v != nil {
+				hashes[k] = *v
+			}
+		}
+
+		results = append(results, ThreatIntelligenceExternalReferenceModel{
+			Description: utils.NormalizeNilableString(item.Description),
+			Hashes:      hashes,
+			SourceName:  utils.NormalizeNilableString(item.SourceName),
+			Url:         utils.NormalizeNilableString(item.URL),
+			Id:          utils.NormalizeNilableString(item.ExternalID),
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,149 @@
+package sentinel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ThreatIntelligenceIndicatorResource struct{}
+
+func TestAccThreatIntelligenceIndicator_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_threat_intelligence_indicator", "test")
+	r := ThreatIntelligenceIndicatorResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccThreatIntelligenceIndicator_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_threat_intelligence_indicator", "test")
+	r := ThreatIntelligenceIndicatorResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccThreatIntelligenceIndicator_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_threat_intelligence_indicator", "test")
+	r := ThreatIntelligenceIndicatorResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ThreatIntelligenceIndicatorResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Sentinel.ThreatIntelligenceIndicatorsClient
+
+	id, err := parse.ThreatIntelligenceIndicatorID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.IndicatorName); err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r ThreatIntelligenceIndicatorResource) basic(data acceptance.TestData) string {
+	template := WatchlistResource{}.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_threat_intelligence_indicator" "test" {
+  log_analytics_workspace_id = azurerm_log_analytics_solution.sentinel.workspace_resource_id
+  display_name               = "acctest-indicator-%d"
+  pattern_type               = "domain-name"
+  pattern                    = "[domain-name:value = 'acctest-%d.example.com']"
+  source                     = "Microsoft Sentinel"
+  validate_from_utc          = "2022-12-14T16:00:00Z"
+}
+`, template, data.RandomInteger, data.RandomInteger)
+}
+
+func (r ThreatIntelligenceIndicatorResource) complete(data acceptance.TestData) string {
+	template := WatchlistResource{}.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_threat_intelligence_indicator" "test" {
+  log_analytics_workspace_id = azurerm_log_analytics_solution.sentinel.workspace_resource_id
+  display_name               = "acctest-indicator-%d"
+  pattern_type               = "domain-name"
+  pattern                    = "[domain-name:value = 'acctest-%d.example.com']"
+  pattern_version            = "2.1"
+  source                     = "Microsoft Sentinel"
+  validate_from_utc          = "2022-12-14T16:00:00Z"
+  validate_until_utc         = "2032-12-14T16:00:00Z"
+  confidence                 = 80
+  created_by                 = "acctest@example.com"
+  description                = "acceptance test indicator"
+  language                   = "en"
+  revoked                    = true
+  tags                       = ["test-tag"]
+  threat_types               = ["malicious-activity"]
+
+  kill_chain_phase {
+    name       = "lockheed-martin-cyber-kill-chain"
+    phase_name = "reconnaissance"
+  }
+
+  external_reference {
+    description = "example reference"
+    source_name = "example"
+    url         = "https://example.com"
+    hashes = {
+      SHA-256 = "6db12788c37247f2316052e142f42f4b259d6561751e5f401a1ae2a6df9c674b"
+    }
+  }
+}
+`, template, data.RandomInteger, data.RandomInteger)
+}
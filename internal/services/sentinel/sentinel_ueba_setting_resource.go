@@ -0,0 +1,241 @@
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2022-01-01-preview/securityinsight"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// the name of the Setting is fixed, there can only be one per Workspace
+const sentinelUebaSettingName = "Ueba"
+
+type UebaSettingResource struct{}
+
+var _ sdk.ResourceWithUpdate = UebaSettingResource{}
+
+type UebaSettingModel struct {
+	LogAnalyticsWorkspaceId string   `tfschema:"log_analytics_workspace_id"`
+	DataSources             []string `tfschema:"data_sources"`
+}
+
+func (r UebaSettingResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"log_analytics_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+		"data_sources": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(securityinsight.UebaDataSourcesAuditLogs),
+					string(securityinsight.UebaDataSourcesAzureActivity),
+					string(securityinsight.UebaDataSourcesSecurityEvent),
+					string(securityinsight.UebaDataSourcesSigninLogs),
+				}, false),
+			},
+		},
+	}
+}
+
+func (r UebaSettingResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r UebaSettingResource) ResourceType() string {
+	return "azurerm_sentinel_ueba_setting"
+}
+
+func (r UebaSettingResource) ModelObject() interface{} {
+	return &UebaSettingModel{}
+}
+
+func (r UebaSettingResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.SettingID
+}
+
+func (r UebaSettingResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ProductSettingsClient
+
+			var model UebaSettingModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(model.LogAnalyticsWorkspaceId)
+			if err != nil {
+				return fmt.Errorf("parsing Log Analytics Workspace ID: %w", err)
+			}
+
+			id := parse.NewSettingID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, sentinelUebaSettingName)
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.Name)
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				if ueba, ok := existing.Value.AsUeba(); ok && ueba.UebaProperties != nil && ueba.DataSources != nil && len(*ueba.DataSources) > 0 {
+					return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				}
+			}
+
+			param := securityinsight.Ueba{
+				UebaProperties: &securityinsight.UebaProperties{
+					DataSources: expandSentinelUebaDataSources(model.DataSources),
+				},
+			}
+
+			if _, err := client.Update(ctx, id.ResourceGroup, id.WorkspaceName, id.Name, param); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r UebaSettingResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ProductSettingsClient
+
+			id, err := parse.SettingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.Name)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			ueba, ok := resp.Value.AsUeba()
+			if !ok {
+				return fmt.Errorf("retrieving %s: expected a Setting of kind %q", id, securityinsight.KindBasicSettingsKindUeba)
+			}
+
+			model := UebaSettingModel{
+				LogAnalyticsWorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName).ID(),
+			}
+
+			if props := ueba.UebaProperties; props != nil {
+				model.DataSources = flattenSentinelUebaDataSources(props.DataSources)
+			}
+
+			// UEBA is disabled by removing all of the Data Sources
+			if len(model.DataSources) == 0 {
+				return metadata.MarkAsGone(id)
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r UebaSettingResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ProductSettingsClient
+
+			id, err := parse.SettingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model UebaSettingModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.Name)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			ueba, ok := existing.Value.AsUeba()
+			if !ok {
+				return fmt.Errorf("retrieving %s: expected a Setting of kind %q", id, securityinsight.KindBasicSettingsKindUeba)
+			}
+
+			param := securityinsight.Ueba{
+				Etag: ueba.Etag,
+				UebaProperties: &securityinsight.UebaProperties{
+					DataSources: expandSentinelUebaDataSources(model.DataSources),
+				},
+			}
+
+			if _, err := client.Update(ctx, id.ResourceGroup, id.WorkspaceName, id.Name, param); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r UebaSettingResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ProductSettingsClient
+
+			id, err := parse.SettingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, id.ResourceGroup, id.WorkspaceName, id.Name); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandSentinelUebaDataSources(input []string) *[]securityinsight.UebaDataSources {
+	output := make([]securityinsight.UebaDataSources, 0)
+	for _, v := range input {
+		output = append(output, securityinsight.UebaDataSources(v))
+	}
+
+	return &output
+}
+
+func flattenSentinelUebaDataSources(input *[]securityinsight.UebaDataSources) []string {
+	output := make([]string, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, string(v))
+	}
+
+	return output
+}
@@ -0,0 +1,142 @@
+package sentinel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2022-01-01-preview/securityinsight"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type UebaSettingResource struct{}
+
+func TestAccUebaSetting_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_ueba_setting", "test")
+	r := UebaSettingResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccUebaSetting_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_ueba_setting", "test")
+	r := UebaSettingResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("data_sources.#").HasValue("4"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccUebaSetting_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_ueba_setting", "test")
+	r := UebaSettingResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r UebaSettingResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Sentinel.ProductSettingsClient
+
+	id, err := parse.SettingID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	ueba, ok := resp.Value.AsUeba()
+	if !ok {
+		return nil, fmt.Errorf("retrieving %s: expected a Setting of kind %q", id, securityinsight.KindBasicSettingsKindUeba)
+	}
+
+	return utils.Bool(ueba.UebaProperties != nil && ueba.DataSources != nil && len(*ueba.DataSources) > 0), nil
+}
+
+func (r UebaSettingResource) basic(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_ueba_setting" "test" {
+  log_analytics_workspace_id = azurerm_sentinel_entity_analytics_setting.test.log_analytics_workspace_id
+  data_sources               = ["SigninLogs"]
+}
+`, template)
+}
+
+func (r UebaSettingResource) complete(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_ueba_setting" "test" {
+  log_analytics_workspace_id = azurerm_sentinel_entity_analytics_setting.test.log_analytics_workspace_id
+  data_sources               = ["AuditLogs", "AzureActivity", "SecurityEvent", "SigninLogs"]
+}
+`, template)
+}
+
+func (r UebaSettingResource) requiresImport(data acceptance.TestData) string {
+	template := r.basic(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_ueba_setting" "import" {
+  log_analytics_workspace_id = azurerm_sentinel_ueba_setting.test.log_analytics_workspace_id
+  data_sources               = azurerm_sentinel_ueba_setting.test.data_sources
+}
+`, template)
+}
+
+func (r UebaSettingResource) template(data acceptance.TestData) string {
+	return EntityAnalyticsSettingResource{}.basic(data)
+}
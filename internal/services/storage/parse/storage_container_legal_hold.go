@@ -0,0 +1,87 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type StorageContainerLegalHoldId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	StorageAccountName string
+	BlobServiceName    string
+	ContainerName      string
+	LegalHoldName      string
+}
+
+func NewStorageContainerLegalHoldID(subscriptionId, resourceGroup, storageAccountName, blobServiceName, containerName, legalHoldName string) StorageContainerLegalHoldId {
+	return StorageContainerLegalHoldId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		StorageAccountName: storageAccountName,
+		BlobServiceName:    blobServiceName,
+		ContainerName:      containerName,
+		LegalHoldName:      legalHoldName,
+	}
+}
+
+func (id StorageContainerLegalHoldId) String() string {
+	segments := []string{
+		fmt.Sprintf("Legal Hold Name %q", id.LegalHoldName),
+		fmt.Sprintf("Container Name %q", id.ContainerName),
+		fmt.Sprintf("Blob Service Name %q", id.BlobServiceName),
+		fmt.Sprintf("Storage Account Name %q", id.StorageAccountName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Storage Container Legal Hold", segmentsStr)
+}
+
+func (id StorageContainerLegalHoldId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s/blobServices/%s/containers/%s/legalHolds/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.BlobServiceName, id.ContainerName, id.LegalHoldName)
+}
+
+// StorageContainerLegalHoldID parses a StorageContainerLegalHold ID into an StorageContainerLegalHoldId struct
+func StorageContainerLegalHoldID(input string) (*StorageContainerLegalHoldId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := StorageContainerLegalHoldId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.StorageAccountName, err = id.PopSegment("storageAccounts"); err != nil {
+		return nil, err
+	}
+	if resourceId.BlobServiceName, err = id.PopSegment("blobServices"); err != nil {
+		return nil, err
+	}
+	if resourceId.ContainerName, err = id.PopSegment("containers"); err != nil {
+		return nil, err
+	}
+	if resourceId.LegalHoldName, err = id.PopSegment("legalHolds"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
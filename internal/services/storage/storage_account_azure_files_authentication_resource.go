@@ -0,0 +1,261 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceStorageAccountAzureFilesAuthentication() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageAccountAzureFilesAuthenticationCreateUpdate,
+		Read:   resourceStorageAccountAzureFilesAuthenticationRead,
+		Update: resourceStorageAccountAzureFilesAuthenticationCreateUpdate,
+		Delete: resourceStorageAccountAzureFilesAuthenticationDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.StorageAccountID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			//lintignore: S013
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageAccountID,
+			},
+
+			"directory_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(storage.DirectoryServiceOptionsAADDS),
+					string(storage.DirectoryServiceOptionsAD),
+				}, false),
+			},
+
+			"active_directory": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"storage_sid": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"domain_guid": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+
+						"domain_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"domain_sid": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"forest_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"netbios_domain_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"default_share_permission": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(storage.DefaultSharePermissionNone),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(storage.DefaultSharePermissionNone),
+					string(storage.DefaultSharePermissionStorageFileDataSmbShareReader),
+					string(storage.DefaultSharePermissionStorageFileDataSmbShareContributor),
+					string(storage.DefaultSharePermissionStorageFileDataSmbShareElevatedContributor),
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceStorageAccountAzureFilesAuthenticationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.AccountsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, storageAccountResourceName)
+	defer locks.UnlockByName(id.Name, storageAccountResourceName)
+
+	storageAccount, err := client.GetProperties(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(storageAccount.Response) {
+			return fmt.Errorf("%s was not found", *id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if storageAccount.AccountProperties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+	}
+
+	existingDirectoryType := storage.DirectoryServiceOptionsNone
+	if auth := storageAccount.AccountProperties.AzureFilesIdentityBasedAuthentication; auth != nil {
+		existingDirectoryType = auth.DirectoryServiceOptions
+	}
+	if d.IsNewResource() && existingDirectoryType != storage.DirectoryServiceOptionsNone {
+		return tf.ImportAsExistsError("azurerm_storage_account_azure_files_authentication", id.ID())
+	}
+
+	directoryType := storage.DirectoryServiceOptions(d.Get("directory_type").(string))
+	activeDirectory := d.Get("active_directory").([]interface{})
+	if directoryType == storage.DirectoryServiceOptionsAD && len(activeDirectory) == 0 {
+		return fmt.Errorf("`active_directory` is required when `directory_type` is `AD`")
+	}
+
+	// due to service issue: https://github.com/Azure/azure-rest-api-specs/issues/12473, we need to update to None before changing its DirectoryServiceOptions
+	if existingDirectoryType != storage.DirectoryServiceOptionsNone && existingDirectoryType != directoryType {
+		log.Print("[DEBUG] Disabling AzureFilesIdentityBasedAuthentication prior to changing DirectoryServiceOptions")
+		if err := updateStorageAccountAzureFilesAuthentication(ctx, client, *id, storage.AzureFilesIdentityBasedAuthentication{
+			DirectoryServiceOptions: storage.DirectoryServiceOptionsNone,
+		}); err != nil {
+			return fmt.Errorf("disabling Azure Files Authentication for %s: %+v", *id, err)
+		}
+	}
+
+	auth := storage.AzureFilesIdentityBasedAuthentication{
+		DirectoryServiceOptions:   directoryType,
+		ActiveDirectoryProperties: expandArmStorageAccountActiveDirectoryProperties(activeDirectory),
+		DefaultSharePermission:    storage.DefaultSharePermission(d.Get("default_share_permission").(string)),
+	}
+	if err := updateStorageAccountAzureFilesAuthentication(ctx, client, *id, auth); err != nil {
+		return fmt.Errorf("updating Azure Files Authentication for %s: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceStorageAccountAzureFilesAuthenticationRead(d, meta)
+}
+
+func resourceStorageAccountAzureFilesAuthenticationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.AccountsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	storageAccount, err := client.GetProperties(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(storageAccount.Response) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	var auth *storage.AzureFilesIdentityBasedAuthentication
+	if props := storageAccount.AccountProperties; props != nil {
+		auth = props.AzureFilesIdentityBasedAuthentication
+	}
+	if auth == nil || auth.DirectoryServiceOptions == storage.DirectoryServiceOptionsNone {
+		log.Printf("[INFO] Azure Files Authentication is not enabled for %s - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("storage_account_id", id.ID())
+	d.Set("directory_type", string(auth.DirectoryServiceOptions))
+
+	defaultSharePermission := string(storage.DefaultSharePermissionNone)
+	if auth.DefaultSharePermission != "" {
+		defaultSharePermission = string(auth.DefaultSharePermission)
+	}
+	d.Set("default_share_permission", defaultSharePermission)
+
+	if err := d.Set("active_directory", flattenArmStorageAccountActiveDirectoryProperties(auth.ActiveDirectoryProperties)); err != nil {
+		return fmt.Errorf("setting `active_directory`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceStorageAccountAzureFilesAuthenticationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.AccountsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, storageAccountResourceName)
+	defer locks.UnlockByName(id.Name, storageAccountResourceName)
+
+	// Azure Files Authentication can't be removed, so we disable it instead
+	if err := updateStorageAccountAzureFilesAuthentication(ctx, client, *id, storage.AzureFilesIdentityBasedAuthentication{
+		DirectoryServiceOptions: storage.DirectoryServiceOptionsNone,
+	}); err != nil {
+		return fmt.Errorf("disabling Azure Files Authentication for %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func updateStorageAccountAzureFilesAuthentication(ctx context.Context, client *storage.AccountsClient, id parse.StorageAccountId, auth storage.AzureFilesIdentityBasedAuthentication) error {
+	opts := storage.AccountUpdateParameters{
+		AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
+			AzureFilesIdentityBasedAuthentication: &auth,
+		},
+	}
+	if _, err := client.Update(ctx, id.ResourceGroup, id.Name, opts); err != nil {
+		return err
+	}
+
+	return nil
+}
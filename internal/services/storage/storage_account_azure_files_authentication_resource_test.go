@@ -0,0 +1,184 @@
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StorageAccountAzureFilesAuthenticationResource struct{}
+
+func TestAccStorageAccountAzureFilesAuthentication_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_azure_files_authentication", "test")
+	r := StorageAccountAzureFilesAuthenticationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageAccountAzureFilesAuthentication_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_azure_files_authentication", "test")
+	r := StorageAccountAzureFilesAuthenticationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStorageAccountAzureFilesAuthentication_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_azure_files_authentication", "test")
+	r := StorageAccountAzureFilesAuthenticationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("default_share_permission").HasValue("StorageFileDataSmbShareReader"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StorageAccountAzureFilesAuthenticationResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StorageAccountID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Storage.AccountsClient.GetProperties(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if resp.AccountProperties == nil || resp.AccountProperties.AzureFilesIdentityBasedAuthentication == nil {
+		return utils.Bool(false), nil
+	}
+
+	return utils.Bool(resp.AccountProperties.AzureFilesIdentityBasedAuthentication.DirectoryServiceOptions != storage.DirectoryServiceOptionsNone), nil
+}
+
+func (r StorageAccountAzureFilesAuthenticationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_azure_files_authentication" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  directory_type     = "AD"
+
+  active_directory {
+    storage_sid         = "S-1-5-21-2400535526-2334094090-2402026252-0012"
+    domain_name         = "adtest.com"
+    domain_sid          = "S-1-5-21-2400535526-2334094090-2402026252-0012"
+    domain_guid         = "aebfc118-9fa9-4732-a21f-d98e41a77ae1"
+    forest_name         = "adtest.com"
+    netbios_domain_name = "adtest.com"
+  }
+}
+`, r.template(data))
+}
+
+func (r StorageAccountAzureFilesAuthenticationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_azure_files_authentication" "import" {
+  storage_account_id = azurerm_storage_account_azure_files_authentication.test.storage_account_id
+  directory_type     = azurerm_storage_account_azure_files_authentication.test.directory_type
+
+  active_directory {
+    storage_sid         = "S-1-5-21-2400535526-2334094090-2402026252-0012"
+    domain_name         = "adtest.com"
+    domain_sid          = "S-1-5-21-2400535526-2334094090-2402026252-0012"
+    domain_guid         = "aebfc118-9fa9-4732-a21f-d98e41a77ae1"
+    forest_name         = "adtest.com"
+    netbios_domain_name = "adtest.com"
+  }
+}
+`, r.basic(data))
+}
+
+func (r StorageAccountAzureFilesAuthenticationResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_azure_files_authentication" "test" {
+  storage_account_id       = azurerm_storage_account.test.id
+  directory_type           = "AD"
+  default_share_permission = "StorageFileDataSmbShareReader"
+
+  active_directory {
+    storage_sid         = "S-1-5-21-2400535526-2334094090-2402026252-1112"
+    domain_name         = "adtest2.com"
+    domain_sid          = "S-1-5-21-2400535526-2334094090-2402026252-1112"
+    domain_guid         = "13a20c9a-d491-47e6-8a39-299e7a32ea27"
+    forest_name         = "adtest2.com"
+    netbios_domain_name = "adtest2.com"
+  }
+}
+`, r.template(data))
+}
+
+func (r StorageAccountAzureFilesAuthenticationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "unlikely23exst2acct%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  lifecycle {
+    ignore_changes = [azure_files_authentication]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
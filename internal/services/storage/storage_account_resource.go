@@ -734,6 +734,11 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"sku_conversion_status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"secondary_location": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -930,6 +935,9 @@ func resourceStorageAccount() *pluginsdk.Resource {
 
 				switch strings.ToUpper(old.(string)) {
 				case "LRS", "GRS", "RAGRS":
+					if storageAccountReplicationTypeSupportsConversion(old.(string), newAccRep) {
+						return false
+					}
 					if newAccRep == "GZRS" || newAccRep == "RAGZRS" || newAccRep == "ZRS" {
 						return true
 					}
@@ -944,6 +952,20 @@ func resourceStorageAccount() *pluginsdk.Resource {
 	}
 }
 
+// storageAccountReplicationTypeSupportsConversion returns whether Azure can convert the Storage Account
+// from the old replication type to the new one in-place, rather than the Storage Account being recreated.
+func storageAccountReplicationTypeSupportsConversion(old, new string) bool {
+	switch strings.ToUpper(old) {
+	case "LRS":
+		return strings.EqualFold(new, "ZRS")
+	case "GRS":
+		return strings.EqualFold(new, "GZRS")
+	case "RAGRS":
+		return strings.EqualFold(new, "RAGZRS")
+	}
+	return false
+}
+
 func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	envName := meta.(*clients.Client).Account.Environment.Name
 	tenantId := meta.(*clients.Client).Account.TenantId
@@ -1748,9 +1770,22 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 	d.Set("account_kind", resp.Kind)
 
 	if sku := resp.Sku; sku != nil {
+		skuName := sku.Name
+		// whilst a conversion is in progress the API returns the original SKU, so we use the target SKU to avoid a diff
+		if props := resp.AccountProperties; props != nil && props.StorageAccountSkuConversionStatus != nil {
+			if status := props.StorageAccountSkuConversionStatus; status.SkuConversionStatus == storage.SkuConversionStatusInProgress && status.TargetSkuName != "" {
+				skuName = status.TargetSkuName
+			}
+		}
 		d.Set("account_tier", sku.Tier)
-		d.Set("account_replication_type", strings.Split(fmt.Sprintf("%v", sku.Name), "_")[1])
+		d.Set("account_replication_type", strings.Split(fmt.Sprintf("%v", skuName), "_")[1])
+	}
+
+	skuConversionStatus := ""
+	if props := resp.AccountProperties; props != nil && props.StorageAccountSkuConversionStatus != nil {
+		skuConversionStatus = string(props.StorageAccountSkuConversionStatus.SkuConversionStatus)
 	}
+	d.Set("sku_conversion_status", skuConversionStatus)
 
 	if props := resp.AccountProperties; props != nil {
 		d.Set("access_tier", props.AccessTier)
@@ -132,12 +132,28 @@ func storageBlobInventoryPolicyResourceSchema() map[string]*pluginsdk.Schema {
 									},
 								},
 
+								"exclude_prefixes": {
+									Type:     pluginsdk.TypeSet,
+									Optional: true,
+									MaxItems: 10,
+									Elem: &pluginsdk.Schema{
+										Type:         pluginsdk.TypeString,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+
 								"include_blob_versions": {
 									Type:     pluginsdk.TypeBool,
 									Optional: true,
 									Default:  false,
 								},
 
+								"include_deleted": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									Default:  false,
+								},
+
 								"include_snapshots": {
 									Type:     pluginsdk.TypeBool,
 									Optional: true,
@@ -281,8 +297,10 @@ func expandBlobInventoryPolicyFilter(input []interface{}) *storage.BlobInventory
 	v := input[0].(map[string]interface{})
 	return &storage.BlobInventoryPolicyFilter{
 		PrefixMatch:         utils.ExpandStringSlice(v["prefix_match"].(*pluginsdk.Set).List()),
+		ExcludePrefix:       utils.ExpandStringSlice(v["exclude_prefixes"].(*pluginsdk.Set).List()),
 		BlobTypes:           utils.ExpandStringSlice(v["blob_types"].(*pluginsdk.Set).List()),
 		IncludeBlobVersions: utils.Bool(v["include_blob_versions"].(bool)),
+		IncludeDeleted:      utils.Bool(v["include_deleted"].(bool)),
 		IncludeSnapshots:    utils.Bool(v["include_snapshots"].(bool)),
 	}
 }
@@ -330,6 +348,10 @@ func flattenBlobInventoryPolicyFilter(input *storage.BlobInventoryPolicyFilter)
 	if input.IncludeBlobVersions != nil {
 		includeBlobVersions = *input.IncludeBlobVersions
 	}
+	var includeDeleted bool
+	if input.IncludeDeleted != nil {
+		includeDeleted = *input.IncludeDeleted
+	}
 	var includeSnapshots bool
 	if input.IncludeSnapshots != nil {
 		includeSnapshots = *input.IncludeSnapshots
@@ -337,7 +359,9 @@ func flattenBlobInventoryPolicyFilter(input *storage.BlobInventoryPolicyFilter)
 	return []interface{}{
 		map[string]interface{}{
 			"blob_types":            utils.FlattenStringSlice(input.BlobTypes),
+			"exclude_prefixes":      utils.FlattenStringSlice(input.ExcludePrefix),
 			"include_blob_versions": includeBlobVersions,
+			"include_deleted":       includeDeleted,
 			"include_snapshots":     includeSnapshots,
 			"prefix_match":          utils.FlattenStringSlice(input.PrefixMatch),
 		},
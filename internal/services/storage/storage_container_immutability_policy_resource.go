@@ -0,0 +1,309 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceStorageContainerImmutabilityPolicy() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageContainerImmutabilityPolicyCreate,
+		Read:   resourceStorageContainerImmutabilityPolicyRead,
+		Update: resourceStorageContainerImmutabilityPolicyUpdate,
+		Delete: resourceStorageContainerImmutabilityPolicyDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.StorageContainerImmutabilityPolicyID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_container_resource_manager_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageContainerResourceManagerID,
+			},
+
+			"immutability_period_in_days": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 146000),
+			},
+
+			"locked": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"protected_append_writes_enabled": {
+				Type:          pluginsdk.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"protected_append_writes_all_enabled"},
+			},
+
+			"protected_append_writes_all_enabled": {
+				Type:          pluginsdk.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"protected_append_writes_enabled"},
+			},
+
+			"version_level_immutability_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			if d.HasChange("version_level_immutability_enabled") {
+				if old, _ := d.GetChange("version_level_immutability_enabled"); old.(bool) {
+					return fmt.Errorf("`version_level_immutability_enabled` cannot be disabled once it's been enabled")
+				}
+			}
+
+			if old, _ := d.GetChange("locked"); !old.(bool) {
+				return nil
+			}
+
+			// once locked, the policy can't be unlocked and only the immutability period can be extended
+			if !d.Get("locked").(bool) {
+				return fmt.Errorf("`locked` cannot be disabled once the Immutability Policy has been locked")
+			}
+			if old, new := d.GetChange("immutability_period_in_days"); new.(int) < old.(int) {
+				return fmt.Errorf("`immutability_period_in_days` can only be increased once the Immutability Policy has been locked")
+			}
+			if d.HasChanges("protected_append_writes_enabled", "protected_append_writes_all_enabled") {
+				return fmt.Errorf("`protected_append_writes_enabled` and `protected_append_writes_all_enabled` cannot be changed once the Immutability Policy has been locked")
+			}
+
+			return nil
+		}),
+	}
+}
+
+func resourceStorageContainerImmutabilityPolicyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	containerId, err := parse.StorageContainerResourceManagerID(d.Get("storage_container_resource_manager_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewStorageContainerImmutabilityPolicyID(containerId.SubscriptionId, containerId.ResourceGroup, containerId.StorageAccountName, containerId.BlobServiceName, containerId.ContainerName, "default")
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *containerId, err)
+	}
+	if props := existing.ContainerProperties; props != nil && props.HasImmutabilityPolicy != nil && *props.HasImmutabilityPolicy {
+		return tf.ImportAsExistsError("azurerm_storage_container_immutability_policy", id.ID())
+	}
+
+	policy := storage.ImmutabilityPolicy{
+		ImmutabilityPolicyProperty: &storage.ImmutabilityPolicyProperty{
+			ImmutabilityPeriodSinceCreationInDays: utils.Int32(int32(d.Get("immutability_period_in_days").(int))),
+			AllowProtectedAppendWrites:            utils.Bool(d.Get("protected_append_writes_enabled").(bool)),
+			AllowProtectedAppendWritesAll:         utils.Bool(d.Get("protected_append_writes_all_enabled").(bool)),
+		},
+	}
+	resp, err := client.CreateOrUpdateImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, &policy, "")
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if d.Get("locked").(bool) {
+		if resp.Etag == nil {
+			return fmt.Errorf("locking %s: `etag` was nil", id)
+		}
+		if _, err := client.LockImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, *resp.Etag); err != nil {
+			return fmt.Errorf("locking %s: %+v", id, err)
+		}
+	}
+
+	if d.Get("version_level_immutability_enabled").(bool) {
+		if err := migrateStorageContainerToVersionLevelImmutability(ctx, client, id); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(id.ID())
+	return resourceStorageContainerImmutabilityPolicyRead(d, meta)
+}
+
+func resourceStorageContainerImmutabilityPolicyRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerImmutabilityPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	containerId := parse.NewStorageContainerResourceManagerID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.BlobServiceName, id.ContainerName)
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s was not found - removing from state", containerId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", containerId, err)
+	}
+
+	props := resp.ContainerProperties
+	if props == nil || props.HasImmutabilityPolicy == nil || !*props.HasImmutabilityPolicy || props.ImmutabilityPolicy == nil || props.ImmutabilityPolicy.ImmutabilityPolicyProperty == nil {
+		log.Printf("[INFO] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("storage_container_resource_manager_id", containerId.ID())
+
+	policy := props.ImmutabilityPolicy.ImmutabilityPolicyProperty
+
+	immutabilityPeriod := 0
+	if policy.ImmutabilityPeriodSinceCreationInDays != nil {
+		immutabilityPeriod = int(*policy.ImmutabilityPeriodSinceCreationInDays)
+	}
+	d.Set("immutability_period_in_days", immutabilityPeriod)
+	d.Set("locked", policy.State == storage.ImmutabilityPolicyStateLocked)
+	d.Set("protected_append_writes_enabled", policy.AllowProtectedAppendWrites != nil && *policy.AllowProtectedAppendWrites)
+	d.Set("protected_append_writes_all_enabled", policy.AllowProtectedAppendWritesAll != nil && *policy.AllowProtectedAppendWritesAll)
+	d.Set("version_level_immutability_enabled", props.ImmutableStorageWithVersioning != nil && props.ImmutableStorageWithVersioning.Enabled != nil && *props.ImmutableStorageWithVersioning.Enabled)
+
+	return nil
+}
+
+func resourceStorageContainerImmutabilityPolicyUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerImmutabilityPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.GetImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.Etag == nil || existing.ImmutabilityPolicyProperty == nil {
+		return fmt.Errorf("retrieving %s: `etag` or `properties` was nil", *id)
+	}
+	etag := *existing.Etag
+
+	if existing.ImmutabilityPolicyProperty.State == storage.ImmutabilityPolicyStateLocked {
+		// a locked policy can only have its immutability period extended
+		if d.HasChange("immutability_period_in_days") {
+			policy := storage.ImmutabilityPolicy{
+				ImmutabilityPolicyProperty: &storage.ImmutabilityPolicyProperty{
+					ImmutabilityPeriodSinceCreationInDays: utils.Int32(int32(d.Get("immutability_period_in_days").(int))),
+				},
+			}
+			if _, err := client.ExtendImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, etag, &policy); err != nil {
+				return fmt.Errorf("extending %s: %+v", *id, err)
+			}
+		}
+	} else {
+		if d.HasChanges("immutability_period_in_days", "protected_append_writes_enabled", "protected_append_writes_all_enabled") {
+			policy := storage.ImmutabilityPolicy{
+				ImmutabilityPolicyProperty: &storage.ImmutabilityPolicyProperty{
+					ImmutabilityPeriodSinceCreationInDays: utils.Int32(int32(d.Get("immutability_period_in_days").(int))),
+					AllowProtectedAppendWrites:            utils.Bool(d.Get("protected_append_writes_enabled").(bool)),
+					AllowProtectedAppendWritesAll:         utils.Bool(d.Get("protected_append_writes_all_enabled").(bool)),
+				},
+			}
+			resp, err := client.CreateOrUpdateImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, &policy, etag)
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+			if resp.Etag == nil {
+				return fmt.Errorf("updating %s: `etag` was nil", *id)
+			}
+			etag = *resp.Etag
+		}
+
+		if d.Get("locked").(bool) {
+			if _, err := client.LockImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, etag); err != nil {
+				return fmt.Errorf("locking %s: %+v", *id, err)
+			}
+		}
+	}
+
+	if d.HasChange("version_level_immutability_enabled") && d.Get("version_level_immutability_enabled").(bool) {
+		if err := migrateStorageContainerToVersionLevelImmutability(ctx, client, *id); err != nil {
+			return err
+		}
+	}
+
+	return resourceStorageContainerImmutabilityPolicyRead(d, meta)
+}
+
+func resourceStorageContainerImmutabilityPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerImmutabilityPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.GetImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.Etag == nil {
+		return fmt.Errorf("retrieving %s: `etag` was nil", *id)
+	}
+
+	// the API returns an error if the policy is locked, which we're intentionally not wrapping since it's descriptive
+	if _, err := client.DeleteImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, *existing.Etag); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func migrateStorageContainerToVersionLevelImmutability(ctx context.Context, client *storage.BlobContainersClient, id parse.StorageContainerImmutabilityPolicyId) error {
+	log.Printf("[DEBUG] Migrating Container %q (Storage Account %q) to version-level immutability..", id.ContainerName, id.StorageAccountName)
+	future, err := client.ObjectLevelWorm(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName)
+	if err != nil {
+		return fmt.Errorf("migrating Container %q (Storage Account %q) to version-level immutability: %+v", id.ContainerName, id.StorageAccountName, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the migration of Container %q (Storage Account %q) to version-level immutability: %+v", id.ContainerName, id.StorageAccountName, err)
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StorageContainerImmutabilityPolicyResource struct{}
+
+func TestAccStorageContainerImmutabilityPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_container_immutability_policy", "test")
+	r := StorageContainerImmutabilityPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageContainerImmutabilityPolicy_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_container_immutability_policy", "test")
+	r := StorageContainerImmutabilityPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStorageContainerImmutabilityPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_container_immutability_policy", "test")
+	r := StorageContainerImmutabilityPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.protectedAppendWrites(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageContainerImmutabilityPolicy_versionLevel(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_container_immutability_policy", "test")
+	r := StorageContainerImmutabilityPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.versionLevel(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("version_level_immutability_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StorageContainerImmutabilityPolicyResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StorageContainerImmutabilityPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Storage.BlobContainersClient.Get(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ContainerProperties != nil && resp.ContainerProperties.HasImmutabilityPolicy != nil && *resp.ContainerProperties.HasImmutabilityPolicy), nil
+}
+
+func (r StorageContainerImmutabilityPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_container_immutability_policy" "test" {
+  storage_container_resource_manager_id = azurerm_storage_container.test.resource_manager_id
+  immutability_period_in_days           = 14
+}
+`, r.template(data, false))
+}
+
+func (r StorageContainerImmutabilityPolicyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_container_immutability_policy" "import" {
+  storage_container_resource_manager_id = azurerm_storage_container_immutability_policy.test.storage_container_resource_manager_id
+  immutability_period_in_days           = azurerm_storage_container_immutability_policy.test.immutability_period_in_days
+}
+`, r.basic(data))
+}
+
+func (r StorageContainerImmutabilityPolicyResource) protectedAppendWrites(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_container_immutability_policy" "test" {
+  storage_container_resource_manager_id = azurerm_storage_container.test.resource_manager_id
+  immutability_period_in_days           = 7
+  protected_append_writes_all_enabled   = true
+}
+`, r.template(data, false))
+}
+
+func (r StorageContainerImmutabilityPolicyResource) versionLevel(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_container_immutability_policy" "test" {
+  storage_container_resource_manager_id = azurerm_storage_container.test.resource_manager_id
+  immutability_period_in_days           = 1
+  protected_append_writes_enabled       = true
+  version_level_immutability_enabled    = true
+}
+`, r.template(data, true))
+}
+
+func (r StorageContainerImmutabilityPolicyResource) template(data acceptance.TestData, versioningEnabled bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  blob_properties {
+    versioning_enabled = %t
+  }
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "vhds"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, versioningEnabled)
+}
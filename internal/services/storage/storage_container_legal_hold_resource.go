@@ -0,0 +1,199 @@
+package storage
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceStorageContainerLegalHold() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageContainerLegalHoldCreate,
+		Read:   resourceStorageContainerLegalHoldRead,
+		Update: resourceStorageContainerLegalHoldUpdate,
+		Delete: resourceStorageContainerLegalHoldDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.StorageContainerLegalHoldID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_container_resource_manager_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageContainerResourceManagerID,
+			},
+
+			"tags": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 10,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.StorageContainerLegalHoldTag,
+				},
+			},
+
+			"protected_append_writes_all_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceStorageContainerLegalHoldCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	containerId, err := parse.StorageContainerResourceManagerID(d.Get("storage_container_resource_manager_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewStorageContainerLegalHoldID(containerId.SubscriptionId, containerId.ResourceGroup, containerId.StorageAccountName, containerId.BlobServiceName, containerId.ContainerName, "default")
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *containerId, err)
+	}
+	if props := existing.ContainerProperties; props != nil && props.HasLegalHold != nil && *props.HasLegalHold {
+		return tf.ImportAsExistsError("azurerm_storage_container_legal_hold", id.ID())
+	}
+
+	legalHold := storage.LegalHold{
+		Tags:                          utils.ExpandStringSlice(d.Get("tags").(*pluginsdk.Set).List()),
+		AllowProtectedAppendWritesAll: utils.Bool(d.Get("protected_append_writes_all_enabled").(bool)),
+	}
+	if _, err := client.SetLegalHold(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, legalHold); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceStorageContainerLegalHoldRead(d, meta)
+}
+
+func resourceStorageContainerLegalHoldRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerLegalHoldID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	props := resp.ContainerProperties
+	if props == nil || props.HasLegalHold == nil || !*props.HasLegalHold {
+		log.Printf("[INFO] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("storage_container_resource_manager_id", parse.NewStorageContainerResourceManagerID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.BlobServiceName, id.ContainerName).ID())
+
+	tags := make([]interface{}, 0)
+	protectedAppendWritesAllEnabled := false
+	if legalHold := props.LegalHold; legalHold != nil {
+		if legalHold.Tags != nil {
+			for _, tag := range *legalHold.Tags {
+				if tag.Tag != nil {
+					tags = append(tags, *tag.Tag)
+				}
+			}
+		}
+		if history := legalHold.ProtectedAppendWritesHistory; history != nil && history.AllowProtectedAppendWritesAll != nil {
+			protectedAppendWritesAllEnabled = *history.AllowProtectedAppendWritesAll
+		}
+	}
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("setting `tags`: %+v", err)
+	}
+	d.Set("protected_append_writes_all_enabled", protectedAppendWritesAllEnabled)
+
+	return nil
+}
+
+func resourceStorageContainerLegalHoldUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerLegalHoldID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// setting a Legal Hold only adds tags, so any tags which have been removed need to be cleared
+	oldRaw, newRaw := d.GetChange("tags")
+	removed := oldRaw.(*pluginsdk.Set).Difference(newRaw.(*pluginsdk.Set)).List()
+	if len(removed) > 0 {
+		legalHold := storage.LegalHold{
+			Tags: utils.ExpandStringSlice(removed),
+		}
+		if _, err := client.ClearLegalHold(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, legalHold); err != nil {
+			return fmt.Errorf("clearing removed tags from %s: %+v", *id, err)
+		}
+	}
+
+	legalHold := storage.LegalHold{
+		Tags:                          utils.ExpandStringSlice(newRaw.(*pluginsdk.Set).List()),
+		AllowProtectedAppendWritesAll: utils.Bool(d.Get("protected_append_writes_all_enabled").(bool)),
+	}
+	if _, err := client.SetLegalHold(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, legalHold); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceStorageContainerLegalHoldRead(d, meta)
+}
+
+func resourceStorageContainerLegalHoldDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerLegalHoldID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	legalHold := storage.LegalHold{
+		Tags: utils.ExpandStringSlice(d.Get("tags").(*pluginsdk.Set).List()),
+	}
+	if _, err := client.ClearLegalHold(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, legalHold); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -193,6 +193,42 @@ func resourceSynapseSparkPool() *pluginsdk.Resource {
 				},
 			},
 
+			"workspace_package": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"path": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"container_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"jar",
+								"whl",
+								"tar.gz",
+							}, false),
+						},
+					},
+				},
+			},
+
 			"spark_version": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -327,6 +363,10 @@ func resourceSynapseSparkPoolRead(d *pluginsdk.ResourceData, meta interface{}) e
 		d.Set("session_level_packages_enabled", props.SessionLevelPackagesEnabled)
 		d.Set("spark_config", flattenSparkPoolSparkConfig(props.SparkConfigProperties))
 		d.Set("spark_version", props.SparkVersion)
+
+		if err := d.Set("workspace_package", flattenSparkPoolWorkspacePackages(props.CustomLibraries)); err != nil {
+			return fmt.Errorf("setting `workspace_package`: %+v", err)
+		}
 	}
 	return tags.FlattenAndSet(d, resp.Tags)
 }
@@ -358,6 +398,7 @@ func resourceSynapseSparkPoolUpdate(d *pluginsdk.ResourceData, meta interface{})
 			DynamicExecutorAllocation: &synapse.DynamicExecutorAllocation{
 				Enabled: utils.Bool(d.Get("dynamic_executor_allocation_enabled").(bool)),
 			},
+			CustomLibraries:             expandSparkPoolWorkspacePackages(d.Get("workspace_package").([]interface{})),
 			DefaultSparkLogFolder:       utils.String(d.Get("spark_log_folder").(string)),
 			LibraryRequirements:         expandArmSparkPoolLibraryRequirements(d.Get("library_requirement").([]interface{})),
 			NodeSize:                    synapse.NodeSize(d.Get("node_size").(string)),
@@ -457,6 +498,23 @@ func expandSparkPoolSparkConfig(input []interface{}) *synapse.LibraryRequirement
 	}
 }
 
+func expandSparkPoolWorkspacePackages(input []interface{}) *[]synapse.LibraryInfo {
+	results := make([]synapse.LibraryInfo, 0)
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+		results = append(results, synapse.LibraryInfo{
+			Name:          utils.String(v["name"].(string)),
+			Path:          utils.String(v["path"].(string)),
+			ContainerName: utils.String(v["container_name"].(string)),
+			Type:          utils.String(v["type"].(string)),
+		})
+	}
+	return &results
+}
+
 func flattenArmSparkPoolAutoPauseProperties(input *synapse.AutoPauseProperties) []interface{} {
 	if input == nil {
 		return make([]interface{}, 0)
@@ -553,3 +611,36 @@ func flattenSparkPoolSparkConfig(input *synapse.LibraryRequirements) []interface
 		},
 	}
 }
+
+func flattenSparkPoolWorkspacePackages(input *[]synapse.LibraryInfo) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		var name string
+		if item.Name != nil {
+			name = *item.Name
+		}
+		var path string
+		if item.Path != nil {
+			path = *item.Path
+		}
+		var containerName string
+		if item.ContainerName != nil {
+			containerName = *item.ContainerName
+		}
+		var libraryType string
+		if item.Type != nil {
+			libraryType = *item.Type
+		}
+		results = append(results, map[string]interface{}{
+			"name":           name,
+			"path":           path,
+			"container_name": containerName,
+			"type":           libraryType,
+		})
+	}
+	return results
+}
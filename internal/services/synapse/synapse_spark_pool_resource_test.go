@@ -91,6 +91,38 @@ func TestAccSynapseSparkPool_update(t *testing.T) {
 	})
 }
 
+func TestAccSynapseSparkPool_workspacePackage(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_spark_pool", "test")
+	r := SynapseSparkPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.workspacePackage(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("workspace_package.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("spark_events_folder", "spark_log_folder"),
+		{
+			Config: r.workspacePackageUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("workspace_package.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("spark_events_folder", "spark_log_folder"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("workspace_package.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("spark_events_folder", "spark_log_folder"),
+	})
+}
+
 func TestAccSynapseSparkPool_sparkVersion(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_synapse_spark_pool", "test")
 	r := SynapseSparkPoolResource{}
@@ -232,6 +264,82 @@ resource "azurerm_synapse_spark_pool" "test" {
 `, template, data.RandomString, sparkVersion)
 }
 
+func (r SynapseSparkPoolResource) workspacePackage(data acceptance.TestData) string {
+	template := r.workspacePackageTemplate(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_spark_pool" "test" {
+  name                 = "acctestSSP%s"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  node_size_family     = "MemoryOptimized"
+  node_size            = "Small"
+  node_count           = 3
+  spark_version        = "3.2"
+
+  workspace_package {
+    name           = "acctest-1.0-py3-none-any.whl"
+    path           = azurerm_storage_blob.whl.name
+    container_name = azurerm_storage_data_lake_gen2_filesystem.test.name
+    type           = "whl"
+  }
+}
+`, template, data.RandomString)
+}
+
+func (r SynapseSparkPoolResource) workspacePackageUpdated(data acceptance.TestData) string {
+	template := r.workspacePackageTemplate(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_spark_pool" "test" {
+  name                 = "acctestSSP%s"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  node_size_family     = "MemoryOptimized"
+  node_size            = "Small"
+  node_count           = 3
+  spark_version        = "3.2"
+
+  workspace_package {
+    name           = "acctest-1.0-py3-none-any.whl"
+    path           = azurerm_storage_blob.whl.name
+    container_name = azurerm_storage_data_lake_gen2_filesystem.test.name
+    type           = "whl"
+  }
+
+  workspace_package {
+    name           = "acctest-1.0.jar"
+    path           = azurerm_storage_blob.jar.name
+    container_name = azurerm_storage_data_lake_gen2_filesystem.test.name
+    type           = "jar"
+  }
+}
+`, template, data.RandomString)
+}
+
+func (r SynapseSparkPoolResource) workspacePackageTemplate(data acceptance.TestData) string {
+	template := r.template(data, data.Locations.Primary)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob" "whl" {
+  name                   = "${azurerm_synapse_workspace.test.name}/libraries/acctest-1.0-py3-none-any.whl"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_data_lake_gen2_filesystem.test.name
+  type                   = "Block"
+  source_content         = "acctest"
+}
+
+resource "azurerm_storage_blob" "jar" {
+  name                   = "${azurerm_synapse_workspace.test.name}/libraries/acctest-1.0.jar"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_data_lake_gen2_filesystem.test.name
+  type                   = "Block"
+  source_content         = "acctest"
+}
+`, template)
+}
+
 func (r SynapseSparkPoolResource) requiresImport(data acceptance.TestData) string {
 	config := r.basic(data)
 	return fmt.Sprintf(`
@@ -126,6 +126,88 @@ func dataSourceArmTrafficManagerProfile() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"endpoint": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"target": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"target_resource_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"endpoint_location": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"monitor_status": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"priority": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"weight": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"geo_mappings": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"subnet": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"first": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+									"last": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+									"scope": {
+										Type:     pluginsdk.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"traffic_view_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
@@ -180,8 +262,106 @@ func dataSourceArmTrafficManagerProfileRead(d *pluginsdk.ResourceData, meta inte
 			if dns := profile.DnsConfig; dns != nil {
 				d.Set("fqdn", dns.Fqdn)
 			}
+
+			if err := d.Set("endpoint", flattenTrafficManagerProfileDataSourceEndpoints(profile.Endpoints)); err != nil {
+				return fmt.Errorf("setting `endpoint`: %+v", err)
+			}
 		}
 		return tags.FlattenAndSet(d, model.Tags)
 	}
 	return nil
 }
+
+func flattenTrafficManagerProfileDataSourceEndpoints(input *[]profiles.Endpoint) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		endpointType := ""
+		if item.Type != nil {
+			endpointType = *item.Type
+		}
+
+		target := ""
+		targetResourceId := ""
+		endpointLocation := ""
+		enabled := false
+		monitorStatus := ""
+		priority := 0
+		weight := 0
+		geoMappings := make([]interface{}, 0)
+		subnets := make([]interface{}, 0)
+		if props := item.Properties; props != nil {
+			if props.Target != nil {
+				target = *props.Target
+			}
+			if props.TargetResourceId != nil {
+				targetResourceId = *props.TargetResourceId
+			}
+			if props.EndpointLocation != nil {
+				endpointLocation = *props.EndpointLocation
+			}
+			if props.EndpointStatus != nil {
+				enabled = *props.EndpointStatus == profiles.EndpointStatusEnabled
+			}
+			if props.EndpointMonitorStatus != nil {
+				monitorStatus = string(*props.EndpointMonitorStatus)
+			}
+			if props.Priority != nil {
+				priority = int(*props.Priority)
+			}
+			if props.Weight != nil {
+				weight = int(*props.Weight)
+			}
+			if props.GeoMapping != nil {
+				for _, v := range *props.GeoMapping {
+					geoMappings = append(geoMappings, v)
+				}
+			}
+			if props.Subnets != nil {
+				for _, subnet := range *props.Subnets {
+					first := ""
+					if subnet.First != nil {
+						first = *subnet.First
+					}
+					last := ""
+					if subnet.Last != nil {
+						last = *subnet.Last
+					}
+					scope := 0
+					if subnet.Scope != nil {
+						scope = int(*subnet.Scope)
+					}
+					subnets = append(subnets, map[string]interface{}{
+						"first": first,
+						"last":  last,
+						"scope": scope,
+					})
+				}
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":               name,
+			"type":               endpointType,
+			"target":             target,
+			"target_resource_id": targetResourceId,
+			"endpoint_location":  endpointLocation,
+			"enabled":            enabled,
+			"monitor_status":     monitorStatus,
+			"priority":           priority,
+			"weight":             weight,
+			"geo_mappings":       geoMappings,
+			"subnet":             subnets,
+		})
+	}
+
+	return results
+}
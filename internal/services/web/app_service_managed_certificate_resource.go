@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -37,10 +38,13 @@ func resourceAppServiceManagedCertificate() *pluginsdk.Resource {
 
 		Schema: map[string]*pluginsdk.Schema{
 			"custom_hostname_binding_id": {
-				Type:         pluginsdk.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validate.AppServiceCustomHostnameBindingID,
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.Any(
+					validate.AppServiceCustomHostnameBindingID,
+					validate.AppServiceSlotCustomHostnameBindingID,
+				),
 			},
 
 			"canonical_name": {
@@ -86,6 +90,11 @@ func resourceAppServiceManagedCertificate() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"is_valid": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -98,20 +107,32 @@ func resourceAppServiceManagedCertificateCreateUpdate(d *pluginsdk.ResourceData,
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	customHostnameBindingId, err := parse.AppServiceCustomHostnameBindingID(d.Get("custom_hostname_binding_id").(string))
-	if err != nil {
-		return err
-	}
+	// the binding can either belong to an App Service (Web or Function App) or to one of its Slots
+	var appService web.Site
+	var name string
+	bindingIdRaw := d.Get("custom_hostname_binding_id").(string)
+	if slotBindingId, err := parse.AppServiceSlotCustomHostnameBindingID(bindingIdRaw); err == nil {
+		name = slotBindingId.HostNameBindingName
+		appService, err = appServiceClient.GetSlot(ctx, slotBindingId.ResourceGroup, slotBindingId.SiteName, slotBindingId.SlotName)
+		if err != nil {
+			return fmt.Errorf("could not retrieve App Service Slot Custom Hostname details for %q: %+v", slotBindingId.HostNameBindingName, err)
+		}
+	} else {
+		customHostnameBindingId, err := parse.AppServiceCustomHostnameBindingID(bindingIdRaw)
+		if err != nil {
+			return err
+		}
 
-	appService, err := appServiceClient.Get(ctx, customHostnameBindingId.ResourceGroup, customHostnameBindingId.AppServiceName)
-	if err != nil {
-		return fmt.Errorf("could not retrieve App Service Custom Hostname details for %q", customHostnameBindingId.Name)
+		name = customHostnameBindingId.Name
+		appService, err = appServiceClient.Get(ctx, customHostnameBindingId.ResourceGroup, customHostnameBindingId.AppServiceName)
+		if err != nil {
+			return fmt.Errorf("could not retrieve App Service Custom Hostname details for %q", customHostnameBindingId.Name)
+		}
 	}
 
-	name := customHostnameBindingId.Name
 	appServicePlanIDRaw := ""
 	if appService.SiteProperties == nil || appService.SiteProperties.ServerFarmID == nil {
-		return fmt.Errorf("could not get App Service Plan ID for Custom Hostname Binding %q (resource group %q)", customHostnameBindingId.Name, customHostnameBindingId.ResourceGroup)
+		return fmt.Errorf("could not get App Service Plan ID for Custom Hostname Binding %q", bindingIdRaw)
 	}
 	appServicePlanIDRaw = *appService.SiteProperties.ServerFarmID
 
@@ -144,7 +165,7 @@ func resourceAppServiceManagedCertificateCreateUpdate(d *pluginsdk.ResourceData,
 
 	certificate := web.Certificate{
 		CertificateProperties: &web.CertificateProperties{
-			CanonicalName: utils.String(customHostnameBindingId.Name),
+			CanonicalName: utils.String(name),
 			ServerFarmID:  utils.String(appServicePlanIDRaw),
 			Password:      new(string),
 		},
@@ -229,6 +250,7 @@ func resourceAppServiceManagedCertificateRead(d *pluginsdk.ResourceData, meta in
 		}
 		d.Set("expiration_date", expirationDate)
 		d.Set("thumbprint", props.Thumbprint)
+		d.Set("is_valid", props.Valid)
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
@@ -67,5 +67,6 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		AppServiceEnvironmentV3Resource{},
+		StaticSiteFunctionAppRegistrationResource{},
 	}
 }
@@ -0,0 +1,176 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-03-01/web"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StaticSiteFunctionAppRegistrationResource struct{}
+
+type StaticSiteFunctionAppRegistrationModel struct {
+	StaticSiteId  string `tfschema:"static_site_id"`
+	FunctionAppId string `tfschema:"function_app_id"`
+}
+
+var _ sdk.Resource = StaticSiteFunctionAppRegistrationResource{}
+
+func (r StaticSiteFunctionAppRegistrationResource) ModelObject() interface{} {
+	return &StaticSiteFunctionAppRegistrationModel{}
+}
+
+func (r StaticSiteFunctionAppRegistrationResource) ResourceType() string {
+	return "azurerm_static_site_function_app_registration"
+}
+
+func (r StaticSiteFunctionAppRegistrationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StaticSiteUserProvidedFunctionAppID
+}
+
+func (r StaticSiteFunctionAppRegistrationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"static_site_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StaticSiteID,
+		},
+
+		"function_app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.FunctionAppID,
+		},
+	}
+}
+
+func (r StaticSiteFunctionAppRegistrationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StaticSiteFunctionAppRegistrationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Web.StaticSitesClient
+			appServicesClient := metadata.Client.Web.AppServicesClient
+
+			var model StaticSiteFunctionAppRegistrationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			staticSiteId, err := parse.StaticSiteID(model.StaticSiteId)
+			if err != nil {
+				return err
+			}
+
+			functionAppId, err := parse.FunctionAppID(model.FunctionAppId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewStaticSiteUserProvidedFunctionAppID(staticSiteId.SubscriptionId, staticSiteId.ResourceGroup, staticSiteId.Name, functionAppId.SiteName)
+			existing, err := client.GetUserProvidedFunctionAppForStaticSite(ctx, id.ResourceGroup, id.StaticSiteName, id.UserProvidedFunctionAppName)
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			functionApp, err := appServicesClient.Get(ctx, functionAppId.ResourceGroup, functionAppId.SiteName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", functionAppId, err)
+			}
+
+			envelope := web.StaticSiteUserProvidedFunctionAppARMResource{
+				StaticSiteUserProvidedFunctionAppARMResourceProperties: &web.StaticSiteUserProvidedFunctionAppARMResourceProperties{
+					FunctionAppResourceID: utils.String(functionAppId.ID()),
+					FunctionAppRegion:     utils.String(location.NormalizeNilable(functionApp.Location)),
+				},
+			}
+
+			// `isForced` overwrites the existing backend configuration of the Function App, which is what is expected when registering it with Terraform
+			future, err := client.RegisterUserProvidedFunctionAppWithStaticSite(ctx, id.ResourceGroup, id.StaticSiteName, id.UserProvidedFunctionAppName, envelope, utils.Bool(true))
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StaticSiteFunctionAppRegistrationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Web.StaticSitesClient
+
+			id, err := parse.StaticSiteUserProvidedFunctionAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetUserProvidedFunctionAppForStaticSite(ctx, id.ResourceGroup, id.StaticSiteName, id.UserProvidedFunctionAppName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := StaticSiteFunctionAppRegistrationModel{
+				StaticSiteId: parse.NewStaticSiteID(id.SubscriptionId, id.ResourceGroup, id.StaticSiteName).ID(),
+			}
+
+			if props := resp.StaticSiteUserProvidedFunctionAppARMResourceProperties; props != nil && props.FunctionAppResourceID != nil {
+				functionAppId, err := parse.FunctionAppID(*props.FunctionAppResourceID)
+				if err != nil {
+					return err
+				}
+				state.FunctionAppId = functionAppId.ID()
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StaticSiteFunctionAppRegistrationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Web.StaticSitesClient
+
+			id, err := parse.StaticSiteUserProvidedFunctionAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if resp, err := client.DetachUserProvidedFunctionAppFromStaticSite(ctx, id.ResourceGroup, id.StaticSiteName, id.UserProvidedFunctionAppName); err != nil {
+				if !utils.ResponseWasNotFound(resp) {
+					return fmt.Errorf("deleting %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
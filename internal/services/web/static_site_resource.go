@@ -5,7 +5,7 @@ import (
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-03-01/web"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
@@ -72,6 +72,12 @@ func resourceStaticSite() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"enterprise_grade_cdn_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"default_host_name": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -126,15 +132,25 @@ func resourceStaticSiteCreateOrUpdate(d *pluginsdk.ResourceData, meta interface{
 		return fmt.Errorf("a Managed Identity cannot be used when tier is set to `Free`")
 	}
 
+	enterpriseGradeCdnStatus := web.EnterpriseGradeCdnStatusDisabled
+	if d.Get("enterprise_grade_cdn_enabled").(bool) {
+		if skuName == string(web.SkuNameFree) {
+			return fmt.Errorf("`enterprise_grade_cdn_enabled` cannot be used when tier is set to `Free`")
+		}
+		enterpriseGradeCdnStatus = web.EnterpriseGradeCdnStatusEnabled
+	}
+
 	siteEnvelope := web.StaticSiteARMResource{
 		Sku: &web.SkuDescription{
 			Name: &skuName,
 			Tier: utils.String(d.Get("sku_tier").(string)),
 		},
-		StaticSite: &web.StaticSite{},
-		Location:   &loc,
-		Identity:   identity,
-		Tags:       tags.Expand(d.Get("tags").(map[string]interface{})),
+		StaticSite: &web.StaticSite{
+			EnterpriseGradeCdnStatus: enterpriseGradeCdnStatus,
+		},
+		Location: &loc,
+		Identity: identity,
+		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.CreateOrUpdateStaticSite(ctx, id.ResourceGroup, id.Name, siteEnvelope)
@@ -186,6 +202,9 @@ func resourceStaticSiteRead(d *pluginsdk.ResourceData, meta interface{}) error {
 			defaultHostname = *prop.DefaultHostname
 		}
 		d.Set("default_host_name", defaultHostname)
+
+		enterpriseGradeCdnEnabled := prop.EnterpriseGradeCdnStatus == web.EnterpriseGradeCdnStatusEnabled || prop.EnterpriseGradeCdnStatus == web.EnterpriseGradeCdnStatusEnabling
+		d.Set("enterprise_grade_cdn_enabled", enterpriseGradeCdnEnabled)
 	}
 
 	skuName := ""
@@ -151,6 +151,37 @@ func TestAccAzureStaticSite_basicUpdate(t *testing.T) {
 	})
 }
 
+func TestAccAzureStaticSite_enterpriseGradeCdn(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_static_site", "test")
+	r := StaticSiteResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basicUpdate(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.enterpriseGradeCdn(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enterprise_grade_cdn_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basicUpdate(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enterprise_grade_cdn_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccAzureStaticSite_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_static_site", "test")
 	r := StaticSiteResource{}
@@ -323,6 +354,33 @@ resource "azurerm_static_site" "test" {
 `, data.RandomInteger, data.Locations.Secondary) // TODO - Put back to primary when support ticket is resolved
 }
 
+func (r StaticSiteResource) enterpriseGradeCdn(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_static_site" "test" {
+  name                         = "acctestSS-%[1]d"
+  location                     = azurerm_resource_group.test.location
+  resource_group_name          = azurerm_resource_group.test.name
+  sku_size                     = "Standard"
+  sku_tier                     = "Standard"
+  enterprise_grade_cdn_enabled = true
+
+  tags = {
+    environment = "acceptance"
+    updated     = "true"
+  }
+}
+`, data.RandomInteger, data.Locations.Secondary) // TODO - Put back to primary when support ticket is resolved
+}
+
 func (r StaticSiteResource) requiresImport(data acceptance.TestData) string {
 	template := r.basic(data)
 	return fmt.Sprintf(`